@@ -1,17 +1,44 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/myramen/be/internal/app/campaign"
 	"github.com/myramen/be/internal/app/coupon"
+	"github.com/myramen/be/internal/app/customer"
+	"github.com/myramen/be/internal/app/inventory"
+	"github.com/myramen/be/internal/app/menu"
 	"github.com/myramen/be/internal/app/order"
+	"github.com/myramen/be/internal/app/pricing"
+	"github.com/myramen/be/internal/app/webhook"
 	"github.com/myramen/be/internal/pkg/config"
 	"github.com/myramen/be/internal/pkg/db/mysql"
+	"github.com/myramen/be/internal/pkg/encryption"
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/idgen"
 	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/pubsub"
+	"github.com/myramen/be/internal/pkg/scheduler"
 
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	// eventHistorySize 재연결 시 다시 보낼 수 있도록 보관하는 최근 이벤트 수
+	eventHistorySize = 1000
+	// shutdownTimeout 종료 신호 후 처리 중인 요청을 기다리는 시간
+	shutdownTimeout = 10 * time.Second
+	// schedulerLockName 주기 작업 리더 선출에 쓰는 MySQL 잠금 이름
+	schedulerLockName = "myramen:scheduler"
+)
+
 func main() {
 	config.Load()
 
@@ -21,28 +48,164 @@ func main() {
 	}
 	defer db.Close()
 
-	orderRepo := mysql.NewOrderRepository(db)
+	keyring, err := encryption.NewKeyring(config.AppConfig.AccountEncryptionKeys, config.AppConfig.AccountEncryptionKeyID)
+	if err != nil {
+		log.Fatalf("Failed to load account encryption keys: %v", err)
+	}
+	if !keyring.Enabled() {
+		log.Printf("WARNING: ACCOUNT_ENCRYPTION_KEYS is not set, account numbers will be stored in plaintext")
+	}
+
+	adminAccounts, err := middleware.ParseAdminAccounts(config.AppConfig.AdminAccounts)
+	if err != nil {
+		log.Fatalf("Failed to load admin accounts: %v", err)
+	}
+	if adminAccounts == nil {
+		log.Printf("WARNING: ADMIN_ACCOUNTS is not set, admin actions will be recorded with unverified X-Admin-Name values")
+	}
+
+	// 계좌번호를 암호화해도 키 없는 해시가 남으면 이름과 계좌번호를 대입해서 찾을 수 있으므로 함께 설정해야 함
+	claimIndex := encryption.NewBlindIndex(config.AppConfig.OrderClaimKey)
+	if !claimIndex.Enabled() {
+		if keyring.Enabled() {
+			log.Fatalf("ORDER_CLAIM_KEY must be set when ACCOUNT_ENCRYPTION_KEYS is set")
+		}
+		log.Printf("WARNING: ORDER_CLAIM_KEY is not set, order claim keys will be computed without a secret")
+	}
+
+	orderRepo := mysql.NewOrderRepository(db, keyring)
 	couponRepo := mysql.NewCouponRepository(db)
+	customerRepo := mysql.NewCustomerRepository(db)
+	menuRepo := mysql.NewMenuRepository(db)
+	inventoryRepo := mysql.NewInventoryRepository(db)
+	pricingRuleRepo := mysql.NewPricingRuleRepository(db)
+	transactor := mysql.NewTransactor(db)
+	idempotencyRepo := mysql.NewIdempotencyRepository(db)
+	webhookRepo := mysql.NewWebhookRepository(db)
+	outboxRepo := mysql.NewOutboxRepository(db)
+	campaignRepo := mysql.NewCampaignRepository(db)
+
+	eventHub := pubsub.NewHub(eventHistorySize)
+	outbox := event.NewOutbox(outboxRepo)
 
-	couponService := coupon.NewService(couponRepo)
-	orderService := order.NewService(orderRepo, couponRepo)
+	ids := idgen.NewRandomGenerator()
+	customerService := customer.NewService(customerRepo, config.AppConfig.CustomerSessionTTL)
+	campaignService := campaign.NewService(campaignRepo)
+	couponService := coupon.NewService(couponRepo, customerService, campaignService, menuRepo, transactor, ids, outbox)
+	inventoryService := inventory.NewService(inventoryRepo, transactor, outbox)
+	menuService := menu.NewService(menuRepo, inventoryService)
+	pricingService := pricing.NewService(pricingRuleRepo)
+	webhookService := webhook.NewService(webhookRepo, transactor)
+	orderService := order.NewService(orderRepo, couponRepo, menuRepo, inventoryService, pricingService, campaignService, claimIndex, transactor, ids, outbox)
 
-	couponHandler := coupon.NewHandler(couponService)
-	orderHandler := order.NewHandler(orderService)
+	campaignHandler := campaign.NewHandler(campaignService)
+	couponHandler := coupon.NewHandler(couponService, customerService)
+	customerHandler := customer.NewHandler(customerService)
+	menuHandler := menu.NewHandler(menuService)
+	inventoryHandler := inventory.NewHandler(inventoryService)
+	pricingHandler := pricing.NewHandler(pricingService)
+	orderHandler := order.NewHandler(orderService, idempotencyRepo, eventHub, customerService, config.AppConfig.KitchenAllowedOrigins)
+	webhookHandler := webhook.NewHandler(webhookService)
+
+	// outbox 이벤트를 내부 버스(실시간 스트림), 웹훅 대기열, 로그로 발행
+	// 서버 종료 시 남은 이벤트를 발행한 뒤 멈춤
+	relay := event.NewRelay(
+		outboxRepo,
+		transactor,
+		config.AppConfig.OutboxPollInterval,
+		webhook.NewSink(webhookService),
+		event.NewLogSink(nil),
+		event.NewBusSink(eventHub),
+	)
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		relay.Run(relayCtx)
+	}()
+
+	// 웹훅 전송은 서버 종료 시 진행 중인 묶음까지 보낸 뒤 멈춤
+	webhookDispatcher := webhook.NewDispatcher(
+		webhookService,
+		&http.Client{Timeout: config.AppConfig.WebhookTimeout},
+		config.AppConfig.WebhookPollInterval,
+		config.AppConfig.WebhookMaxAttempts,
+	)
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	dispatcherDone := make(chan struct{})
+	go func() {
+		defer close(dispatcherDone)
+		webhookDispatcher.Run(dispatcherCtx)
+	}()
+
+	// 주기 작업은 리더 잠금을 가진 인스턴스 하나에서만 실행
+	jobs := scheduler.New(
+		scheduler.SystemClock{},
+		mysql.NewAdvisoryLocker(db),
+		schedulerLockName,
+		config.AppConfig.SchedulerInterval,
+	)
+	jobs.Register(couponService.ExpiryJob(config.AppConfig.CouponExpiryInterval, config.AppConfig.CouponExpiryReminder))
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		jobs.Run(schedulerCtx)
+	}()
 
 	router := gin.New()
 	router.Use(gin.Recovery())
-	router.Use(gin.Logger())
+	router.Use(middleware.Logger())
 	router.Use(middleware.ErrorHandler())
 
 	api := router.Group("/api/v1")
 	{
 		orderHandler.RegisterRoutes(api)
 		couponHandler.RegisterRoutes(api)
+		campaignHandler.RegisterRoutes(api)
+		customerHandler.RegisterRoutes(api)
+		menuHandler.RegisterRoutes(api)
+		inventoryHandler.RegisterRoutes(api)
+		pricingHandler.RegisterRoutes(api)
+		webhookHandler.RegisterRoutes(api)
 	}
 
-	log.Printf("Starting server on port %s", config.AppConfig.AppPort)
-	if err := router.Run(":" + config.AppConfig.AppPort); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	server := &http.Server{
+		Addr:    ":" + config.AppConfig.AppPort,
+		Handler: router,
 	}
+
+	go func() {
+		log.Printf("Starting server on port %s", config.AppConfig.AppPort)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Printf("Shutting down server")
+
+	// 실시간 스트림 구독을 먼저 닫아야 Shutdown이 열린 연결을 기다리지 않음
+	eventHub.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("Failed to shut down server gracefully: %v", err)
+	}
+
+	// 진행 중인 주기 작업이 기록한 이벤트까지 발행되도록 relay보다 먼저 멈춤
+	stopScheduler()
+	<-schedulerDone
+
+	// 남은 이벤트를 웹훅 대기열에 넣은 뒤 전송을 멈춤
+	stopRelay()
+	<-relayDone
+
+	stopDispatcher()
+	<-dispatcherDone
 }
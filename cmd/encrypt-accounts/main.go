@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/myramen/be/internal/pkg/config"
+	"github.com/myramen/be/internal/pkg/db/mysql"
+	"github.com/myramen/be/internal/pkg/encryption"
+)
+
+// 기존 주문의 평문 계좌번호를 암호화하거나, 키 교체 후 이전 키로 암호화된 값을 활성 키로 다시 암호화한다.
+func main() {
+	batchSize := flag.Int("batch", 500, "number of orders to process per batch")
+	flag.Parse()
+
+	config.Load()
+
+	keyring, err := encryption.NewKeyring(config.AppConfig.AccountEncryptionKeys, config.AppConfig.AccountEncryptionKeyID)
+	if err != nil {
+		log.Fatalf("Failed to load account encryption keys: %v", err)
+	}
+
+	db, err := mysql.NewConnection()
+	if err != nil {
+		log.Fatalf("Failed to connect to MySQL: %v", err)
+	}
+	defer db.Close()
+
+	updated, err := mysql.EncryptAccountNumbers(context.Background(), db, keyring, *batchSize)
+	if err != nil {
+		log.Fatalf("Failed to encrypt account numbers after %d orders: %v", updated, err)
+	}
+
+	log.Printf("Encrypted account numbers of %d orders with key %s", updated, keyring.ActiveKeyID())
+}
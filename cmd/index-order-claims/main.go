@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/myramen/be/internal/pkg/config"
+	"github.com/myramen/be/internal/pkg/db/mysql"
+	"github.com/myramen/be/internal/pkg/encryption"
+)
+
+// 계정 연결 기능 이전에 저장된 주문의 claim_key(이름 + 계좌번호 블라인드 인덱스)를 계산한다.
+// ORDER_CLAIM_KEY를 교체했다면 -all로 모든 주문을 다시 계산해야 한다.
+func main() {
+	batchSize := flag.Int("batch", 500, "number of orders to process per batch")
+	all := flag.Bool("all", false, "recompute claim keys of all orders (after rotating ORDER_CLAIM_KEY)")
+	flag.Parse()
+
+	config.Load()
+
+	keyring, err := encryption.NewKeyring(config.AppConfig.AccountEncryptionKeys, config.AppConfig.AccountEncryptionKeyID)
+	if err != nil {
+		log.Fatalf("Failed to load account encryption keys: %v", err)
+	}
+
+	index := encryption.NewBlindIndex(config.AppConfig.OrderClaimKey)
+	if !index.Enabled() {
+		if keyring.Enabled() {
+			log.Fatalf("ORDER_CLAIM_KEY must be set when ACCOUNT_ENCRYPTION_KEYS is set")
+		}
+		log.Printf("WARNING: ORDER_CLAIM_KEY is not set, claim keys will be computed without a secret")
+	}
+
+	db, err := mysql.NewConnection()
+	if err != nil {
+		log.Fatalf("Failed to connect to MySQL: %v", err)
+	}
+	defer db.Close()
+
+	updated, err := mysql.IndexOrderClaimKeys(context.Background(), db, keyring, index, *batchSize, *all)
+	if err != nil {
+		log.Fatalf("Failed to index order claim keys after %d orders: %v", updated, err)
+	}
+
+	log.Printf("Indexed claim keys of %d orders", updated)
+}
@@ -0,0 +1,36 @@
+package campaign
+
+import (
+	"time"
+)
+
+// CampaignRequest 캠페인 생성/수정 요청 DTO (수정 시 전체 교체)
+type CampaignRequest struct {
+	Name           string      `json:"name" binding:"required,max=100"`
+	Active         *bool       `json:"active,omitempty"`
+	StartsAt       *time.Time  `json:"startsAt,omitempty"`
+	EndsAt         *time.Time  `json:"endsAt,omitempty"`
+	Budget         int         `json:"budget" binding:"min=0"`
+	MaxRedemptions int         `json:"maxRedemptions" binding:"min=0"`
+	MaxPerCustomer int         `json:"maxPerCustomer" binding:"min=0"`
+	Reward         *RewardRule `json:"reward,omitempty"`
+}
+
+// CampaignResponse 캠페인 응답 DTO (사용 현황 포함)
+type CampaignResponse struct {
+	Campaign
+	RemainingBudget *int   `json:"remainingBudget,omitempty"` // 남은 예산 (예산 제한이 없으면 생략)
+	Status          string `json:"status"`                    // ACTIVE, SCHEDULED, ENDED, INACTIVE, EXHAUSTED
+}
+
+// CampaignListResponse 캠페인 목록 응답 DTO
+type CampaignListResponse struct {
+	Campaigns []CampaignResponse `json:"campaigns"`
+}
+
+// ErrorResponse 에러 응답 DTO
+type ErrorResponse struct {
+	Error   string      `json:"error"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
@@ -0,0 +1,56 @@
+package campaign
+
+import (
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 캠페인 관련 에러
+var (
+	ErrCampaignNotFound  = errors.NotFound("NOT_FOUND", "해당 캠페인을 찾을 수 없습니다.")
+	ErrCampaignNotActive = errors.BadRequest("CAMPAIGN_NOT_ACTIVE", "진행 중인 캠페인이 아닙니다.")
+)
+
+// ErrBudgetExhausted 캠페인 예산이 부족해서 쿠폰을 발급하거나 사용할 수 없음
+func ErrBudgetExhausted(campaign *Campaign, amount int) error {
+	return errors.NewError(
+		errors.StatusConflict,
+		"CAMPAIGN_BUDGET_EXHAUSTED",
+		"쿠폰 캠페인 예산이 모두 소진되었습니다.",
+		map[string]interface{}{
+			"campaignId":      campaign.ID,
+			"remainingBudget": campaign.RemainingBudget(),
+			"discount":        amount,
+		},
+	)
+}
+
+// ErrRedemptionLimit 캠페인 쿠폰의 전체 사용 횟수 한도에 도달함
+func ErrRedemptionLimit(campaign *Campaign) error {
+	return errors.NewError(
+		errors.StatusConflict,
+		"CAMPAIGN_REDEMPTION_LIMIT",
+		"쿠폰 캠페인의 사용 가능 횟수가 모두 소진되었습니다.",
+		map[string]interface{}{
+			"campaignId":     campaign.ID,
+			"maxRedemptions": campaign.MaxRedemptions,
+		},
+	)
+}
+
+// ErrCustomerLimit 고객 한 명의 캠페인 쿠폰 사용 횟수 한도에 도달함
+func ErrCustomerLimit(campaign *Campaign) error {
+	return errors.NewError(
+		errors.StatusConflict,
+		"CAMPAIGN_CUSTOMER_LIMIT",
+		"이 캠페인의 쿠폰을 더 사용할 수 없습니다.",
+		map[string]interface{}{
+			"campaignId":     campaign.ID,
+			"maxPerCustomer": campaign.MaxPerCustomer,
+		},
+	)
+}
+
+// invalidCampaign 캠페인 설정 오류
+func invalidCampaign(message string) error {
+	return errors.BadRequest("INVALID_CAMPAIGN", message)
+}
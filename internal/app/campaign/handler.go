@@ -0,0 +1,118 @@
+package campaign
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 캠페인 핸들러
+type Handler struct {
+	service *Service
+}
+
+// NewHandler 캠페인 핸들러 생성
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes 라우트 등록
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	admin := r.Group("/admin")
+	{
+		admin.Use(middleware.AdminAuth())
+		admin.GET("/campaigns", h.GetCampaigns)
+		admin.POST("/campaigns", h.CreateCampaign)
+		admin.GET("/campaigns/:campaignId", h.GetCampaign)
+		admin.PUT("/campaigns/:campaignId", h.UpdateCampaign)
+	}
+}
+
+// GetCampaigns 캠페인 목록 조회 핸들러
+func (h *Handler) GetCampaigns(c *gin.Context) {
+	result, err := h.service.GetCampaigns(c)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CreateCampaign 캠페인 생성 핸들러
+func (h *Handler) CreateCampaign(c *gin.Context) {
+	var req CampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "캠페인 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.CreateCampaign(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetCampaign 캠페인 조회 핸들러
+func (h *Handler) GetCampaign(c *gin.Context) {
+	id, ok := campaignIDParam(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.GetCampaign(c, id)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// UpdateCampaign 캠페인 수정 핸들러
+func (h *Handler) UpdateCampaign(c *gin.Context) {
+	id, ok := campaignIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req CampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "캠페인 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.UpdateCampaign(c, id, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// campaignIDParam 경로의 캠페인 ID 파싱 (숫자가 아니면 404 응답)
+func campaignIDParam(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("campaignId"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "NOT_FOUND",
+			Message: "해당 캠페인을 찾을 수 없습니다.",
+		})
+		return 0, false
+	}
+	return id, true
+}
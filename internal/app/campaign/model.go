@@ -0,0 +1,121 @@
+package campaign
+
+import (
+	"strconv"
+	"time"
+)
+
+// Campaign 쿠폰 캠페인 (캠페인 쿠폰의 예산과 사용 한도, 구매 보상 쿠폰 규칙)
+type Campaign struct {
+	ID             int64       `json:"campaignId"`
+	Name           string      `json:"name"`
+	Active         bool        `json:"active"`
+	StartsAt       *time.Time  `json:"startsAt,omitempty"`
+	EndsAt         *time.Time  `json:"endsAt,omitempty"`
+	Budget         int         `json:"budget"`         // 총 할인 예산 (원, 0이면 제한 없음)
+	MaxRedemptions int         `json:"maxRedemptions"` // 전체 사용 횟수 한도 (0이면 제한 없음)
+	MaxPerCustomer int         `json:"maxPerCustomer"` // 고객 한 명의 사용 횟수 한도 (0이면 제한 없음)
+	Reward         *RewardRule `json:"reward,omitempty"`
+	Spent          int         `json:"spent"`       // 지금까지 할인한 금액 (취소된 주문 제외)
+	Redemptions    int         `json:"redemptions"` // 지금까지 사용된 횟수 (취소된 주문 제외)
+	IssuedCount    int         `json:"issuedCount"` // 발급한 쿠폰 수
+	CreatedAt      time.Time   `json:"createdAt"`
+	UpdatedAt      time.Time   `json:"updatedAt"`
+}
+
+// RewardRule 구매 보상 쿠폰 규칙 (MinQuantity개 이상 주문하면 Discount원 쿠폰을 ValidDays일 동안 사용 가능하게 발급)
+type RewardRule struct {
+	MinQuantity int `json:"minQuantity" binding:"min=1"`
+	Discount    int `json:"discount" binding:"min=1"`
+	ValidDays   int `json:"validDays" binding:"min=1,max=365"`
+}
+
+// Redemption 캠페인 쿠폰 사용 기록
+type Redemption struct {
+	ID          int64      `json:"id"`
+	CampaignID  int64      `json:"campaignId"`
+	CouponID    string     `json:"couponId"`
+	OrderID     string     `json:"orderId"`
+	CustomerKey string     `json:"-"`
+	Amount      int        `json:"amount"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	RefundedAt  *time.Time `json:"refundedAt,omitempty"`
+}
+
+// 캠페인 상태 (저장된 값이 아니라 활성화 여부, 기간, 사용 현황으로 판단)
+const (
+	StatusActive    = "ACTIVE"
+	StatusScheduled = "SCHEDULED"
+	StatusEnded     = "ENDED"
+	StatusInactive  = "INACTIVE"
+	StatusExhausted = "EXHAUSTED"
+)
+
+// Status 주어진 시각의 캠페인 상태
+func (c *Campaign) Status(at time.Time) string {
+	switch {
+	case !c.Active:
+		return StatusInactive
+	case c.StartsAt != nil && at.Before(*c.StartsAt):
+		return StatusScheduled
+	case c.EndsAt != nil && !at.Before(*c.EndsAt):
+		return StatusEnded
+	case c.BudgetExhausted() || c.RedemptionsExhausted():
+		return StatusExhausted
+	default:
+		return StatusActive
+	}
+}
+
+// ActiveAt 캠페인이 해당 시각에 진행 중인지 여부 (쿠폰 발급 기준)
+func (c *Campaign) ActiveAt(at time.Time) bool {
+	if !c.Active {
+		return false
+	}
+
+	if c.StartsAt != nil && at.Before(*c.StartsAt) {
+		return false
+	}
+
+	if c.EndsAt != nil && !at.Before(*c.EndsAt) {
+		return false
+	}
+
+	return true
+}
+
+// BudgetExhausted 예산을 모두 사용했는지 여부
+func (c *Campaign) BudgetExhausted() bool {
+	return c.Budget > 0 && c.Spent >= c.Budget
+}
+
+// RedemptionsExhausted 전체 사용 횟수 한도에 도달했는지 여부
+func (c *Campaign) RedemptionsExhausted() bool {
+	return c.MaxRedemptions > 0 && c.Redemptions >= c.MaxRedemptions
+}
+
+// RemainingBudget 남은 예산 (제한이 없으면 nil)
+func (c *Campaign) RemainingBudget() *int {
+	if c.Budget == 0 {
+		return nil
+	}
+
+	remaining := c.Budget - c.Spent
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &remaining
+}
+
+// CustomerKey 고객별 사용 한도를 세는 기준 (로그인 주문은 고객 ID, 비로그인 주문은 claim key, 둘 다 없으면 빈 문자열)
+func CustomerKey(customerID int64, claimKey string) string {
+	if customerID != 0 {
+		return "customer:" + strconv.FormatInt(customerID, 10)
+	}
+
+	if claimKey != "" {
+		return "claim:" + claimKey
+	}
+
+	return ""
+}
@@ -0,0 +1,33 @@
+package campaign
+
+import (
+	"context"
+	"time"
+)
+
+// Repository 캠페인 리포지토리 인터페이스
+type Repository interface {
+	// Create 캠페인 생성
+	Create(ctx context.Context, campaign *Campaign) error
+
+	// FindByID ID로 캠페인 조회 (없으면 nil)
+	FindByID(ctx context.Context, id int64) (*Campaign, error)
+
+	// FindByIDForUpdate ID로 캠페인을 잠가서 조회 (트랜잭션 안에서 호출, 없으면 nil)
+	FindByIDForUpdate(ctx context.Context, id int64) (*Campaign, error)
+
+	// FindAll 전체 캠페인을 생성 순으로 조회
+	FindAll(ctx context.Context) ([]Campaign, error)
+
+	// Update 캠페인 설정 수정 (사용 금액과 횟수는 바꾸지 않음)
+	Update(ctx context.Context, campaign *Campaign) error
+
+	// CountRedemptions 고객의 취소되지 않은 캠페인 쿠폰 사용 횟수
+	CountRedemptions(ctx context.Context, campaignID int64, customerKey string) (int, error)
+
+	// AddRedemption 사용 기록 추가와 캠페인 사용 금액, 횟수 증가
+	AddRedemption(ctx context.Context, redemption *Redemption) error
+
+	// RefundRedemption 주문의 사용 기록을 취소 처리하고 사용 금액, 횟수를 되돌림 (기록이 없으면 nil)
+	RefundRedemption(ctx context.Context, orderID string, refundedAt time.Time) (*Redemption, error)
+}
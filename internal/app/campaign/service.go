@@ -0,0 +1,238 @@
+package campaign
+
+import (
+	"context"
+	"time"
+)
+
+// Service 캠페인 서비스
+type Service struct {
+	repo Repository
+}
+
+// NewService 캠페인 서비스 생성
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// GetCampaigns 전체 캠페인과 사용 현황 조회
+func (s *Service) GetCampaigns(ctx context.Context) (*CampaignListResponse, error) {
+	campaigns, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	responses := make([]CampaignResponse, 0, len(campaigns))
+	for i := range campaigns {
+		responses = append(responses, newCampaignResponse(&campaigns[i], now))
+	}
+
+	return &CampaignListResponse{Campaigns: responses}, nil
+}
+
+// GetCampaign 캠페인과 사용 현황 조회
+func (s *Service) GetCampaign(ctx context.Context, id int64) (*CampaignResponse, error) {
+	campaign, err := s.findCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newCampaignResponse(campaign, time.Now())
+	return &response, nil
+}
+
+// CreateCampaign 캠페인 생성
+func (s *Service) CreateCampaign(ctx context.Context, req CampaignRequest) (*CampaignResponse, error) {
+	campaign := &Campaign{
+		Active:    true,
+		CreatedAt: time.Now(),
+	}
+
+	if err := applyCampaignRequest(campaign, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, campaign); err != nil {
+		return nil, err
+	}
+
+	response := newCampaignResponse(campaign, time.Now())
+	return &response, nil
+}
+
+// UpdateCampaign 캠페인 설정 수정 (예산을 사용 금액보다 낮추면 바로 소진 상태가 됨)
+func (s *Service) UpdateCampaign(ctx context.Context, id int64, req CampaignRequest) (*CampaignResponse, error) {
+	campaign, err := s.findCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyCampaignRequest(campaign, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, campaign); err != nil {
+		return nil, err
+	}
+
+	response := newCampaignResponse(campaign, time.Now())
+	return &response, nil
+}
+
+// CheckIssue 캠페인 쿠폰을 발급할 수 있는지 확인 (진행 중이고 예산과 사용 횟수가 남아 있어야 함)
+func (s *Service) CheckIssue(ctx context.Context, id int64, at time.Time) (*Campaign, error) {
+	campaign, err := s.findCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.ActiveAt(at) {
+		return nil, ErrCampaignNotActive
+	}
+
+	if campaign.BudgetExhausted() {
+		return nil, ErrBudgetExhausted(campaign, 0)
+	}
+
+	if campaign.RedemptionsExhausted() {
+		return nil, ErrRedemptionLimit(campaign)
+	}
+
+	return campaign, nil
+}
+
+// RewardFor 주문 수량에 맞는 구매 보상 캠페인 (없으면 nil)
+// 진행 중이고 예산이 남은 보상 캠페인 중 할인 금액이 가장 큰 캠페인을 고르며, 같으면 먼저 만든 캠페인을 고른다.
+func (s *Service) RewardFor(ctx context.Context, quantity int, at time.Time) (*Campaign, error) {
+	campaigns, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *Campaign
+	for i := range campaigns {
+		campaign := &campaigns[i]
+		if campaign.Reward == nil || quantity < campaign.Reward.MinQuantity {
+			continue
+		}
+
+		if !campaign.ActiveAt(at) || campaign.BudgetExhausted() || campaign.RedemptionsExhausted() {
+			continue
+		}
+
+		if best == nil || campaign.Reward.Discount > best.Reward.Discount {
+			best = campaign
+		}
+	}
+
+	return best, nil
+}
+
+// CheckSpend 캠페인 쿠폰을 사용할 수 있는지 확인 (기록하지 않음, 주문 견적용)
+func (s *Service) CheckSpend(ctx context.Context, redemption *Redemption) error {
+	campaign, err := s.findCampaign(ctx, redemption.CampaignID)
+	if err != nil {
+		return err
+	}
+
+	return s.checkSpend(ctx, campaign, redemption)
+}
+
+// Spend 캠페인 쿠폰 사용을 기록하고 예산을 차감 (트랜잭션 안에서 호출)
+// 캠페인 행을 잠근 뒤 한도를 확인하므로 동시에 주문해도 예산과 사용 한도를 넘지 않는다.
+func (s *Service) Spend(ctx context.Context, redemption *Redemption) error {
+	campaign, err := s.repo.FindByIDForUpdate(ctx, redemption.CampaignID)
+	if err != nil {
+		return err
+	}
+
+	if campaign == nil {
+		return ErrCampaignNotFound
+	}
+
+	if err := s.checkSpend(ctx, campaign, redemption); err != nil {
+		return err
+	}
+
+	return s.repo.AddRedemption(ctx, redemption)
+}
+
+// Refund 취소된 주문의 캠페인 쿠폰 사용을 되돌림 (트랜잭션 안에서 호출, 사용 기록이 없으면 아무것도 하지 않음)
+func (s *Service) Refund(ctx context.Context, orderID string, refundedAt time.Time) error {
+	_, err := s.repo.RefundRedemption(ctx, orderID, refundedAt)
+	return err
+}
+
+// checkSpend 사용 시각에 진행 중인지와 예산, 전체 사용 횟수, 고객별 사용 횟수 한도 확인
+// 발급 후 캠페인이 끝났거나 비활성화되면 이미 발급한 쿠폰도 쓸 수 없다.
+func (s *Service) checkSpend(ctx context.Context, campaign *Campaign, redemption *Redemption) error {
+	if !campaign.ActiveAt(redemption.CreatedAt) {
+		return ErrCampaignNotActive
+	}
+
+	if campaign.Budget > 0 && campaign.Spent+redemption.Amount > campaign.Budget {
+		return ErrBudgetExhausted(campaign, redemption.Amount)
+	}
+
+	if campaign.RedemptionsExhausted() {
+		return ErrRedemptionLimit(campaign)
+	}
+
+	if campaign.MaxPerCustomer > 0 && redemption.CustomerKey != "" {
+		count, err := s.repo.CountRedemptions(ctx, campaign.ID, redemption.CustomerKey)
+		if err != nil {
+			return err
+		}
+
+		if count >= campaign.MaxPerCustomer {
+			return ErrCustomerLimit(campaign)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) findCampaign(ctx context.Context, id int64) (*Campaign, error) {
+	campaign, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign == nil {
+		return nil, ErrCampaignNotFound
+	}
+
+	return campaign, nil
+}
+
+// applyCampaignRequest 요청 내용을 검증해서 캠페인에 반영
+func applyCampaignRequest(campaign *Campaign, req CampaignRequest) error {
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		return invalidCampaign("종료 일시는 시작 일시보다 뒤여야 합니다.")
+	}
+
+	campaign.Name = req.Name
+	campaign.StartsAt = req.StartsAt
+	campaign.EndsAt = req.EndsAt
+	campaign.Budget = req.Budget
+	campaign.MaxRedemptions = req.MaxRedemptions
+	campaign.MaxPerCustomer = req.MaxPerCustomer
+	campaign.Reward = req.Reward
+	campaign.UpdatedAt = time.Now()
+
+	if req.Active != nil {
+		campaign.Active = *req.Active
+	}
+
+	return nil
+}
+
+// newCampaignResponse 캠페인 응답 생성
+func newCampaignResponse(campaign *Campaign, at time.Time) CampaignResponse {
+	return CampaignResponse{
+		Campaign:        *campaign,
+		RemainingBudget: campaign.RemainingBudget(),
+		Status:          campaign.Status(at),
+	}
+}
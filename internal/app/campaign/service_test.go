@@ -0,0 +1,77 @@
+package campaign
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeRepo 캠페인 하나를 보관하는 저장소
+// 인터페이스를 임베드해서 사용 한도 확인에 쓰는 메서드만 구현한다.
+type fakeRepo struct {
+	Repository
+
+	campaign    Campaign
+	redemptions []Redemption
+}
+
+func (r *fakeRepo) FindByID(ctx context.Context, id int64) (*Campaign, error) {
+	if id != r.campaign.ID {
+		return nil, nil
+	}
+
+	campaign := r.campaign
+	return &campaign, nil
+}
+
+func (r *fakeRepo) FindByIDForUpdate(ctx context.Context, id int64) (*Campaign, error) {
+	return r.FindByID(ctx, id)
+}
+
+func (r *fakeRepo) AddRedemption(ctx context.Context, redemption *Redemption) error {
+	r.redemptions = append(r.redemptions, *redemption)
+	r.campaign.Spent += redemption.Amount
+	r.campaign.Redemptions++
+	return nil
+}
+
+func TestSpendRequiresActiveCampaign(t *testing.T) {
+	startsAt := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		active bool
+		at     time.Time
+		want   error
+	}{
+		{name: "during the campaign", active: true, at: startsAt.Add(time.Hour), want: nil},
+		{name: "before it starts", active: true, at: startsAt.Add(-time.Hour), want: ErrCampaignNotActive},
+		{name: "after it ends", active: true, at: endsAt, want: ErrCampaignNotActive},
+		{name: "deactivated", active: false, at: startsAt.Add(time.Hour), want: ErrCampaignNotActive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepo{campaign: Campaign{ID: 1, Active: tt.active, StartsAt: &startsAt, EndsAt: &endsAt}}
+			service := NewService(repo)
+			redemption := &Redemption{CampaignID: 1, CouponID: "7K3M-Q9XD-2HF5", OrderID: "o1", Amount: 500, CreatedAt: tt.at}
+
+			if err := service.CheckSpend(context.Background(), redemption); err != tt.want {
+				t.Errorf("CheckSpend error = %v, want %v", err, tt.want)
+			}
+
+			if err := service.Spend(context.Background(), redemption); err != tt.want {
+				t.Errorf("Spend error = %v, want %v", err, tt.want)
+			}
+
+			wantRecorded := 0
+			if tt.want == nil {
+				wantRecorded = 1
+			}
+			if len(repo.redemptions) != wantRecorded {
+				t.Errorf("recorded %d redemptions, want %d", len(repo.redemptions), wantRecorded)
+			}
+		})
+	}
+}
@@ -0,0 +1,408 @@
+package coupon
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/myramen/be/internal/app/menu"
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/idgen"
+)
+
+// IssueCoupon 관리자가 지정한 할인 내용과 만료일로 쿠폰 발급
+func (s *Service) IssueCoupon(ctx context.Context, req IssueCouponRequest, actor string) (*CouponResponse, error) {
+	issuedAt := time.Now()
+
+	coupon, err := newCouponFromSpec(req.CouponSpec, issuedAt)
+	if err != nil {
+		return nil, err
+	}
+	coupon.CouponID = s.ids.NewCouponID()
+
+	if err := s.checkFreeOption(ctx, coupon); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCampaign(ctx, coupon); err != nil {
+		return nil, err
+	}
+
+	if req.CustomerID != 0 {
+		if _, err := s.customers.GetCustomer(ctx, req.CustomerID); err != nil {
+			return nil, err
+		}
+		coupon.CustomerID = req.CustomerID
+	}
+
+	details := map[string]interface{}{}
+	if coupon.CustomerID != 0 {
+		details["customerId"] = coupon.CustomerID
+	}
+	if req.Note != "" {
+		details["note"] = req.Note
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.CreateCoupon(ctx, coupon); err != nil {
+			return err
+		}
+
+		return s.repo.AddAuditEntry(ctx, &AuditEntry{
+			CouponID:  coupon.CouponID,
+			Action:    AuditIssued,
+			Actor:     actor,
+			Details:   details,
+			CreatedAt: issuedAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := newCouponResponse(coupon)
+	return &response, nil
+}
+
+// IssueBatch 같은 할인 내용의 쿠폰을 한 번에 여러 장 발급 (이벤트 배포용, 소유자 없음)
+func (s *Service) IssueBatch(ctx context.Context, req IssueBatchRequest, actor string) (*BatchResponse, error) {
+	issuedAt := time.Now()
+
+	// 요청 검증은 발급 전에 한 번만
+	template, err := newCouponFromSpec(req.CouponSpec, issuedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkFreeOption(ctx, template); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCampaign(ctx, template); err != nil {
+		return nil, err
+	}
+
+	batch := &Batch{
+		Name:        req.Name,
+		CouponCount: req.Count,
+		CreatedBy:   actor,
+		CreatedAt:   issuedAt,
+	}
+
+	coupons := make([]Coupon, 0, req.Count)
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.CreateBatch(ctx, batch); err != nil {
+			return err
+		}
+
+		for i := 0; i < req.Count; i++ {
+			coupon, err := newCouponFromSpec(req.CouponSpec, issuedAt)
+			if err != nil {
+				return err
+			}
+			coupon.CouponID = s.ids.NewCouponID()
+			coupon.BatchID = batch.ID
+
+			if err := s.CreateCoupon(ctx, coupon); err != nil {
+				return err
+			}
+
+			if err := s.repo.AddAuditEntry(ctx, &AuditEntry{
+				CouponID:  coupon.CouponID,
+				Action:    AuditIssued,
+				Actor:     actor,
+				Details:   map[string]interface{}{"batchId": batch.ID, "batchName": batch.Name},
+				CreatedAt: issuedAt,
+			}); err != nil {
+				return err
+			}
+
+			coupons = append(coupons, *coupon)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newBatchResponse(batch, coupons), nil
+}
+
+// GetBatch 쿠폰 발급 묶음과 쿠폰의 현재 상태 조회
+func (s *Service) GetBatch(ctx context.Context, batchID int64) (*BatchResponse, error) {
+	batch, err := s.repo.FindBatch(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if batch == nil {
+		return nil, ErrBatchNotFound
+	}
+
+	coupons, err := s.repo.FindByBatch(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBatchResponse(batch, coupons), nil
+}
+
+// RevokeCoupon 쿠폰 사용 중지 (사용된 쿠폰은 중지할 수 없음)
+func (s *Service) RevokeCoupon(ctx context.Context, couponID string, req RevokeCouponRequest, actor string) (*CouponResponse, error) {
+	couponID = idgen.NormalizeCouponID(couponID)
+	if !idgen.IsCouponID(couponID) {
+		return nil, ErrCouponNotVisible
+	}
+
+	var revoked *Coupon
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		coupon, err := s.repo.FindByIDForUpdate(ctx, couponID)
+		if err != nil {
+			return err
+		}
+
+		if coupon == nil {
+			return ErrCouponNotVisible
+		}
+
+		if coupon.RevokedAt != nil {
+			return ErrCouponAlreadyRevoked
+		}
+
+		if coupon.IsUsed {
+			return ErrCouponAlreadyRedeemed
+		}
+
+		revokedAt := time.Now()
+		coupon.RevokedAt = &revokedAt
+		coupon.RevokedReason = req.Reason
+
+		if err := s.repo.Update(ctx, coupon); err != nil {
+			return err
+		}
+
+		if err := s.repo.AddAuditEntry(ctx, &AuditEntry{
+			CouponID:  couponID,
+			Action:    AuditRevoked,
+			Actor:     actor,
+			Details:   map[string]interface{}{"reason": req.Reason},
+			CreatedAt: revokedAt,
+		}); err != nil {
+			return err
+		}
+
+		revoked = coupon
+		return s.events.Record(ctx, event.AggregateCoupon, couponID, event.CouponRevoked, &CouponEvent{
+			CouponID:   couponID,
+			Discount:   coupon.Discount,
+			Reason:     req.Reason,
+			OccurredAt: revokedAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := newCouponResponse(revoked)
+	return &response, nil
+}
+
+// ExtendCoupon 쿠폰 만료일 연장 (이미 만료된 미사용 쿠폰도 연장하면 다시 사용할 수 있음)
+func (s *Service) ExtendCoupon(ctx context.Context, couponID string, req ExtendCouponRequest, actor string) (*CouponResponse, error) {
+	couponID = idgen.NormalizeCouponID(couponID)
+	if !idgen.IsCouponID(couponID) {
+		return nil, ErrCouponNotVisible
+	}
+
+	var extended *Coupon
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		coupon, err := s.repo.FindByIDForUpdate(ctx, couponID)
+		if err != nil {
+			return err
+		}
+
+		if coupon == nil {
+			return ErrCouponNotVisible
+		}
+
+		if coupon.RevokedAt != nil {
+			return ErrCouponRevoked
+		}
+
+		if coupon.IsUsed {
+			return ErrCouponAlreadyRedeemed
+		}
+
+		extendedAt := time.Now()
+		if !req.ExpiryDate.After(coupon.ExpiryDate) || !req.ExpiryDate.After(extendedAt) {
+			return ErrInvalidCouponSpec("새 만료일은 현재 만료일과 현재 시각 이후여야 합니다.")
+		}
+
+		previousExpiryDate := coupon.ExpiryDate
+		coupon.ExpiryDate = req.ExpiryDate
+		// 만료 처리와 만료 임박 알림은 새 만료일 기준으로 다시 함
+		coupon.ExpiredAt = nil
+		coupon.ExpiryRemindedAt = nil
+
+		if err := s.repo.Update(ctx, coupon); err != nil {
+			return err
+		}
+
+		details := map[string]interface{}{
+			"previousExpiryDate": previousExpiryDate,
+			"expiryDate":         coupon.ExpiryDate,
+		}
+		if req.Note != "" {
+			details["note"] = req.Note
+		}
+
+		if err := s.repo.AddAuditEntry(ctx, &AuditEntry{
+			CouponID:  couponID,
+			Action:    AuditExtended,
+			Actor:     actor,
+			Details:   details,
+			CreatedAt: extendedAt,
+		}); err != nil {
+			return err
+		}
+
+		extended = coupon
+		return s.events.Record(ctx, event.AggregateCoupon, couponID, event.CouponExtended, &CouponEvent{
+			CouponID:   couponID,
+			Discount:   coupon.Discount,
+			ExpiryDate: &coupon.ExpiryDate,
+			OccurredAt: extendedAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := newCouponResponse(extended)
+	return &response, nil
+}
+
+// checkCampaign 캠페인 쿠폰이면 캠페인이 진행 중이고 예산이 남아 있는지 확인
+func (s *Service) checkCampaign(ctx context.Context, coupon *Coupon) error {
+	if coupon.CampaignID == 0 {
+		return nil
+	}
+
+	_, err := s.campaigns.CheckIssue(ctx, coupon.CampaignID, coupon.IssuedAt)
+	return err
+}
+
+// checkFreeOption 무료 옵션 쿠폰이면 freeOption이 메뉴에 등록된 추가 옵션인지 확인
+// 없는 옵션으로 발급하면 어느 주문에서도 쓸 수 없는 쿠폰이 되므로 발급할 때 거절한다.
+func (s *Service) checkFreeOption(ctx context.Context, coupon *Coupon) error {
+	if coupon.Type != TypeFreeOption {
+		return nil
+	}
+
+	item, err := s.menuRepo.FindByID(ctx, coupon.FreeOption)
+	if err != nil {
+		return err
+	}
+
+	if item == nil || item.Category != menu.CategoryOption {
+		return ErrInvalidCouponSpec("freeOption은 메뉴에 등록된 추가 옵션 ID여야 합니다.")
+	}
+
+	return nil
+}
+
+// newCouponFromSpec 관리자 발급 요청을 검증하고 미사용 쿠폰 생성 (쿠폰 코드는 호출자가 채움)
+func newCouponFromSpec(spec CouponSpec, issuedAt time.Time) (*Coupon, error) {
+	switch spec.Type {
+	case TypeFixed:
+		if spec.Discount <= 0 || spec.DiscountPercent != 0 || spec.MaxDiscount != 0 || spec.FreeOption != "" {
+			return nil, ErrInvalidCouponSpec("정액 쿠폰은 discount만 1원 이상으로 지정해야 합니다.")
+		}
+	case TypePercent:
+		if spec.DiscountPercent <= 0 || spec.Discount != 0 || spec.FreeOption != "" {
+			return nil, ErrInvalidCouponSpec("정률 쿠폰은 discountPercent(1~100)와 선택적인 maxDiscount만 지정해야 합니다.")
+		}
+	case TypeFreeOption:
+		if spec.FreeOption == "" || spec.Discount != 0 || spec.DiscountPercent != 0 || spec.MaxDiscount != 0 {
+			return nil, ErrInvalidCouponSpec("무료 옵션 쿠폰은 freeOption만 지정해야 합니다.")
+		}
+	}
+
+	if spec.Conditions.MinOrderAmount < 0 {
+		return nil, ErrInvalidCouponSpec("최소 주문 금액은 0원 이상이어야 합니다.")
+	}
+
+	expiryDate := issuedAt.Add(ExpiryDuration)
+	switch {
+	case spec.ExpiryDate != nil && spec.ValidDays > 0:
+		return nil, ErrInvalidCouponSpec("만료일과 유효 기간 중 하나만 지정해야 합니다.")
+	case spec.ExpiryDate != nil:
+		if !spec.ExpiryDate.After(issuedAt) {
+			return nil, ErrInvalidCouponSpec("만료일은 현재 시각 이후여야 합니다.")
+		}
+		expiryDate = *spec.ExpiryDate
+	case spec.ValidDays > 0:
+		expiryDate = issuedAt.AddDate(0, 0, spec.ValidDays)
+	}
+
+	return &Coupon{
+		Type:            spec.Type,
+		Discount:        spec.Discount,
+		DiscountPercent: spec.DiscountPercent,
+		MaxDiscount:     spec.MaxDiscount,
+		FreeOption:      spec.FreeOption,
+		Conditions:      spec.Conditions,
+		CampaignID:      spec.CampaignID,
+		ExpiryDate:      expiryDate,
+		IsUsed:          false,
+		IssuedAt:        issuedAt,
+	}, nil
+}
+
+// newBatchResponse 쿠폰 발급 묶음 응답 생성
+func newBatchResponse(batch *Batch, coupons []Coupon) *BatchResponse {
+	couponResponses := make([]CouponResponse, 0, len(coupons))
+	for _, coupon := range coupons {
+		couponResponses = append(couponResponses, newCouponResponse(&coupon))
+	}
+
+	return &BatchResponse{
+		Batch:   *batch,
+		Coupons: couponResponses,
+	}
+}
+
+// batchCSVHeader 쿠폰 발급 묶음 CSV의 열 이름
+var batchCSVHeader = []string{
+	"couponId", "type", "discount", "discountPercent", "maxDiscount", "freeOption", "expiryDate", "status", "issuedAt",
+}
+
+// WriteBatchCSV 쿠폰 발급 묶음을 배포용 CSV로 작성 (쿠폰 한 장당 한 줄)
+func WriteBatchCSV(w io.Writer, batch *BatchResponse) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(batchCSVHeader); err != nil {
+		return err
+	}
+
+	for _, coupon := range batch.Coupons {
+		if err := writer.Write([]string{
+			coupon.CouponID,
+			coupon.Type,
+			strconv.Itoa(coupon.Discount),
+			strconv.Itoa(coupon.DiscountPercent),
+			strconv.Itoa(coupon.MaxDiscount),
+			coupon.FreeOption,
+			coupon.ExpiryDate.Format(time.RFC3339),
+			coupon.Status,
+			coupon.IssuedAt.Format(time.RFC3339),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,74 @@
+package coupon
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/myramen/be/internal/app/menu"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// fakeMenuRepo 메뉴 항목을 메모리에 보관하는 저장소
+type fakeMenuRepo struct {
+	menu.Repository
+
+	items map[string]*menu.Item
+}
+
+func (r *fakeMenuRepo) FindByID(ctx context.Context, itemID string) (*menu.Item, error) {
+	return r.items[itemID], nil
+}
+
+// fakeIDs 순서대로 번호를 붙이는 ID 생성기
+type fakeIDs struct {
+	next int
+}
+
+func (g *fakeIDs) NewOrderID() string {
+	g.next++
+	return "o" + strconv.Itoa(g.next)
+}
+
+func (g *fakeIDs) NewCouponID() string {
+	g.next++
+	return "c" + strconv.Itoa(g.next)
+}
+
+func TestIssueCouponRequiresKnownFreeOption(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepo{coupons: map[string]*Coupon{}}
+	menus := &fakeMenuRepo{items: map[string]*menu.Item{
+		menu.OptionCookingService: {ID: menu.OptionCookingService, Category: menu.CategoryOption},
+		"shin_ramyun":             {ID: "shin_ramyun", Category: menu.CategoryRamen},
+	}}
+	service := NewService(repo, nil, nil, menus, fakeTx{}, &fakeIDs{}, &fakeRecorder{})
+
+	spec := func(freeOption string) CouponSpec {
+		return CouponSpec{Type: TypeFreeOption, FreeOption: freeOption}
+	}
+
+	for _, freeOption := range []string{"no_such_option", "shin_ramyun"} {
+		_, err := service.IssueCoupon(ctx, IssueCouponRequest{CouponSpec: spec(freeOption)}, "admin:김철수")
+		if customErr, ok := err.(errors.CustomError); !ok || customErr.Code != "INVALID_COUPON_SPEC" {
+			t.Errorf("IssueCoupon() with freeOption %q error = %v, want INVALID_COUPON_SPEC", freeOption, err)
+		}
+
+		_, err = service.IssueBatch(ctx, IssueBatchRequest{CouponSpec: spec(freeOption), Name: "이벤트", Count: 3}, "admin:김철수")
+		if customErr, ok := err.(errors.CustomError); !ok || customErr.Code != "INVALID_COUPON_SPEC" {
+			t.Errorf("IssueBatch() with freeOption %q error = %v, want INVALID_COUPON_SPEC", freeOption, err)
+		}
+	}
+
+	if len(repo.coupons) != 0 {
+		t.Fatalf("issued %d coupons for unknown options, want 0", len(repo.coupons))
+	}
+
+	issued, err := service.IssueCoupon(ctx, IssueCouponRequest{CouponSpec: spec(menu.OptionCookingService)}, "admin:김철수")
+	if err != nil {
+		t.Fatalf("IssueCoupon() with a menu option error = %v", err)
+	}
+	if issued.FreeOption != menu.OptionCookingService {
+		t.Errorf("issued freeOption = %q, want %q", issued.FreeOption, menu.OptionCookingService)
+	}
+}
@@ -6,11 +6,23 @@ import (
 
 // CouponResponse 쿠폰 응답 DTO
 type CouponResponse struct {
-	CouponID   string    `json:"couponId"`
-	Discount   int       `json:"discount"`
-	ExpiryDate time.Time `json:"expiryDate"`
-	IsUsed     bool      `json:"isUsed"`
-	IssuedAt   time.Time `json:"issuedAt"`
+	CouponID        string     `json:"couponId"`
+	Type            string     `json:"type"`
+	Discount        int        `json:"discount"`
+	DiscountPercent int        `json:"discountPercent,omitempty"`
+	MaxDiscount     int        `json:"maxDiscount,omitempty"`
+	FreeOption      string     `json:"freeOption,omitempty"`
+	Conditions      Conditions `json:"conditions"`
+	ExpiryDate      time.Time  `json:"expiryDate"`
+	IsUsed          bool       `json:"isUsed"`
+	UsedAt          *time.Time `json:"usedAt,omitempty"`
+	Status          string     `json:"status"`
+	RevokedAt       *time.Time `json:"revokedAt,omitempty"`
+	RevokedReason   string     `json:"revokedReason,omitempty"`
+	ExpiredAt       *time.Time `json:"expiredAt,omitempty"`
+	BatchID         int64      `json:"batchId,omitempty"`
+	CampaignID      int64      `json:"campaignId,omitempty"`
+	IssuedAt        time.Time  `json:"issuedAt"`
 }
 
 // CouponListResponse 쿠폰 목록 응답 DTO
@@ -24,3 +36,67 @@ type ErrorResponse struct {
 	Message string      `json:"message"`
 	Details interface{} `json:"details,omitempty"`
 }
+
+// TransferCouponRequest 쿠폰 양도 요청 DTO
+type TransferCouponRequest struct {
+	RecipientEmail string `json:"recipientEmail" binding:"required,email"`
+	Note           string `json:"note" binding:"max=200"`
+}
+
+// TransferCouponResponse 쿠폰 양도 응답 DTO
+type TransferCouponResponse struct {
+	CouponID       string    `json:"couponId"`
+	RecipientEmail string    `json:"recipientEmail"`
+	TransferredAt  time.Time `json:"transferredAt"`
+}
+
+// AuditLogResponse 쿠폰 감사 기록 응답 DTO
+type AuditLogResponse struct {
+	CouponID string       `json:"couponId"`
+	Entries  []AuditEntry `json:"entries"`
+}
+
+// CouponSpec 관리자가 발급할 쿠폰의 할인 내용과 만료일
+// 만료일은 expiryDate와 validDays 중 하나로 지정하며, 둘 다 없으면 발급일로부터 30일이다.
+type CouponSpec struct {
+	Type            string     `json:"type" binding:"required,oneof=FIXED PERCENT FREE_OPTION"`
+	Discount        int        `json:"discount" binding:"min=0"`
+	DiscountPercent int        `json:"discountPercent" binding:"min=0,max=100"`
+	MaxDiscount     int        `json:"maxDiscount" binding:"min=0"`
+	FreeOption      string     `json:"freeOption" binding:"max=50"`
+	Conditions      Conditions `json:"conditions"`
+	ExpiryDate      *time.Time `json:"expiryDate"`
+	ValidDays       int        `json:"validDays" binding:"min=0,max=365"`
+	CampaignID      int64      `json:"campaignId" binding:"min=0"` // 쿠폰이 속할 캠페인 (선택, 진행 중이고 예산이 남아 있어야 함)
+}
+
+// IssueCouponRequest 관리자 쿠폰 발급 요청 DTO
+type IssueCouponRequest struct {
+	CouponSpec
+	CustomerID int64  `json:"customerId" binding:"min=0"` // 쿠폰을 받을 고객 (0이면 코드를 아는 누구나 사용)
+	Note       string `json:"note" binding:"max=200"`
+}
+
+// IssueBatchRequest 관리자 쿠폰 대량 발급 요청 DTO
+type IssueBatchRequest struct {
+	CouponSpec
+	Name  string `json:"name" binding:"required,max=100"`
+	Count int    `json:"count" binding:"required,min=1,max=1000"`
+}
+
+// RevokeCouponRequest 쿠폰 사용 중지 요청 DTO
+type RevokeCouponRequest struct {
+	Reason string `json:"reason" binding:"required,max=200"`
+}
+
+// ExtendCouponRequest 쿠폰 만료일 연장 요청 DTO
+type ExtendCouponRequest struct {
+	ExpiryDate time.Time `json:"expiryDate" binding:"required"`
+	Note       string    `json:"note" binding:"max=200"`
+}
+
+// BatchResponse 쿠폰 발급 묶음 응답 DTO
+type BatchResponse struct {
+	Batch
+	Coupons []CouponResponse `json:"coupons"`
+}
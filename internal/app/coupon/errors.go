@@ -0,0 +1,59 @@
+package coupon
+
+import (
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 쿠폰 사용 관련 에러
+var (
+	ErrCouponNotFound          = errors.BadRequest("INVALID_COUPON", "사용할 수 없는 쿠폰입니다.")
+	ErrCouponExpired           = errors.BadRequest("INVALID_COUPON", "만료된 쿠폰입니다.")
+	ErrCouponAlreadyRedeemed   = errors.Conflict("COUPON_ALREADY_REDEEMED", "이미 사용된 쿠폰입니다.")
+	ErrCouponRevoked           = errors.BadRequest("COUPON_REVOKED", "사용이 중지된 쿠폰입니다.")
+	ErrCouponAlreadyRevoked    = errors.Conflict("COUPON_ALREADY_REVOKED", "이미 사용이 중지된 쿠폰입니다.")
+	ErrBatchNotFound           = errors.NotFound("NOT_FOUND", "해당 쿠폰 발급 묶음을 찾을 수 없습니다.")
+	ErrCouponNotVisible        = errors.NotFound("NOT_FOUND", "해당 쿠폰을 찾을 수 없습니다.")
+	ErrRecipientNotFound       = errors.NotFound("RECIPIENT_NOT_FOUND", "쿠폰을 받을 고객을 찾을 수 없습니다.")
+	ErrInvalidTransfer         = errors.BadRequest("INVALID_TRANSFER", "자기 자신에게는 쿠폰을 양도할 수 없습니다.")
+	ErrCouponStateChanged      = errors.Conflict("COUPON_STATE_CHANGED", "처리 중 쿠폰 상태가 변경되었습니다. 다시 시도해주세요.")
+	ErrCouponOptionNotSelected = errors.BadRequest("COUPON_OPTION_NOT_SELECTED", "쿠폰으로 무료가 되는 추가 옵션을 선택하지 않았습니다.")
+	ErrCouponNothingToDiscount = errors.BadRequest("COUPON_NOTHING_TO_DISCOUNT", "이 주문에는 쿠폰으로 할인할 금액이 없습니다.")
+)
+
+// ErrInvalidCouponSpec 관리자 쿠폰 발급, 만료일 연장 요청의 설정이 잘못됨
+func ErrInvalidCouponSpec(message string) error {
+	return errors.BadRequest("INVALID_COUPON_SPEC", message)
+}
+
+// ErrCouponDeliveryOption 배달 방식이 쿠폰 사용 조건에 맞지 않음
+func ErrCouponDeliveryOption(conditions Conditions) error {
+	return errors.NewError(
+		errors.StatusBadRequest,
+		"COUPON_DELIVERY_OPTION_MISMATCH",
+		"이 배달 방식에는 사용할 수 없는 쿠폰입니다.",
+		map[string]interface{}{"deliveryOptions": conditions.DeliveryOptions},
+	)
+}
+
+// ErrCouponMenuItem 쿠폰을 적용할 수 있는 메뉴가 주문에 없음
+func ErrCouponMenuItem(conditions Conditions) error {
+	return errors.NewError(
+		errors.StatusBadRequest,
+		"COUPON_MENU_ITEM_MISMATCH",
+		"쿠폰을 적용할 수 있는 메뉴가 주문에 없습니다.",
+		map[string]interface{}{"menuItemIds": conditions.MenuItemIDs},
+	)
+}
+
+// ErrCouponMinOrderAmount 주문 금액이 쿠폰 최소 주문 금액보다 적음
+func ErrCouponMinOrderAmount(conditions Conditions, orderAmount int) error {
+	return errors.NewError(
+		errors.StatusBadRequest,
+		"COUPON_MIN_ORDER_AMOUNT",
+		"최소 주문 금액을 채우지 않아 쿠폰을 사용할 수 없습니다.",
+		map[string]interface{}{
+			"minOrderAmount": conditions.MinOrderAmount,
+			"orderAmount":    orderAmount,
+		},
+	)
+}
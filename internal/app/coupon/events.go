@@ -0,0 +1,18 @@
+package coupon
+
+import (
+	"time"
+)
+
+// CouponEvent 쿠폰 발급/사용/만료/만료 임박/양도/사용 중지/만료일 연장 이벤트
+type CouponEvent struct {
+	CouponID       string     `json:"couponId"`
+	CustomerID     int64      `json:"customerId,omitempty"` // 쿠폰을 가진 고객 (만료, 만료 임박 이벤트)
+	OrderID        string     `json:"orderId,omitempty"`
+	Discount       int        `json:"discount"`
+	ExpiryDate     *time.Time `json:"expiryDate,omitempty"`
+	FromCustomerID int64      `json:"fromCustomerId,omitempty"`
+	ToCustomerID   int64      `json:"toCustomerId,omitempty"`
+	Reason         string     `json:"reason,omitempty"`
+	OccurredAt     time.Time  `json:"occurredAt"`
+}
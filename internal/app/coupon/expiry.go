@@ -0,0 +1,130 @@
+package coupon
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/scheduler"
+)
+
+// expiryBatchSize 만료 처리, 만료 임박 알림을 한 트랜잭션에서 처리하는 최대 쿠폰 수
+const expiryBatchSize = 100
+
+// ExpiryJob 쿠폰 만료 처리 작업
+// interval마다 만료일이 지난 쿠폰을 만료 처리하고, reminderLead가 0보다 크면 만료 reminderLead 전에 만료 임박 알림 이벤트를 기록한다.
+func (s *Service) ExpiryJob(interval time.Duration, reminderLead time.Duration) scheduler.Job {
+	return scheduler.Job{
+		Name:     "coupon-expiry",
+		Interval: interval,
+		Run: func(ctx context.Context, now time.Time) error {
+			expired, err := s.ExpireCoupons(ctx, now)
+			if expired > 0 {
+				log.Printf("coupon expiry: expired %d coupons", expired)
+			}
+			if err != nil {
+				return err
+			}
+
+			if reminderLead <= 0 {
+				return nil
+			}
+
+			reminded, err := s.RemindExpiringCoupons(ctx, now, reminderLead)
+			if reminded > 0 {
+				log.Printf("coupon expiry: sent %d expiry reminders", reminded)
+			}
+			return err
+		},
+	}
+}
+
+// ExpireCoupons 만료일이 now 이전인 미사용 쿠폰을 만료 처리하고 coupon.expired 이벤트 기록 (처리한 쿠폰 수 반환)
+func (s *Service) ExpireCoupons(ctx context.Context, now time.Time) (int, error) {
+	return s.sweep(ctx, func(ctx context.Context) (int, error) {
+		coupons, err := s.repo.FindExpired(ctx, now, expiryBatchSize)
+		if err != nil {
+			return 0, err
+		}
+
+		for i := range coupons {
+			coupon := &coupons[i]
+			if err := s.repo.MarkExpired(ctx, coupon.CouponID, now); err != nil {
+				return 0, err
+			}
+
+			if err := s.repo.AddAuditEntry(ctx, &AuditEntry{
+				CouponID:  coupon.CouponID,
+				Action:    AuditExpired,
+				Actor:     ActorSystem,
+				Details:   map[string]interface{}{"expiryDate": coupon.ExpiryDate},
+				CreatedAt: now,
+			}); err != nil {
+				return 0, err
+			}
+
+			if err := s.recordExpiryEvent(ctx, event.CouponExpired, coupon, now); err != nil {
+				return 0, err
+			}
+		}
+
+		return len(coupons), nil
+	})
+}
+
+// RemindExpiringCoupons now부터 lead 안에 만료되는 미사용 쿠폰마다 coupon.expiring_soon 이벤트를 한 번 기록 (기록한 쿠폰 수 반환)
+func (s *Service) RemindExpiringCoupons(ctx context.Context, now time.Time, lead time.Duration) (int, error) {
+	return s.sweep(ctx, func(ctx context.Context) (int, error) {
+		coupons, err := s.repo.FindExpiring(ctx, now, now.Add(lead), expiryBatchSize)
+		if err != nil {
+			return 0, err
+		}
+
+		for i := range coupons {
+			coupon := &coupons[i]
+			if err := s.repo.MarkReminded(ctx, coupon.CouponID, now); err != nil {
+				return 0, err
+			}
+
+			if err := s.recordExpiryEvent(ctx, event.CouponExpiringSoon, coupon, now); err != nil {
+				return 0, err
+			}
+		}
+
+		return len(coupons), nil
+	})
+}
+
+// sweep 처리할 쿠폰이 없을 때까지 batch를 묶음마다 하나의 트랜잭션으로 반복 실행하고 처리한 수 합계 반환
+func (s *Service) sweep(ctx context.Context, batch func(ctx context.Context) (int, error)) (int, error) {
+	total := 0
+	for ctx.Err() == nil {
+		var count int
+		err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+			var err error
+			count, err = batch(ctx)
+			return err
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += count
+		if count < expiryBatchSize {
+			break
+		}
+	}
+
+	return total, nil
+}
+
+func (s *Service) recordExpiryEvent(ctx context.Context, eventType string, coupon *Coupon, at time.Time) error {
+	return s.events.Record(ctx, event.AggregateCoupon, coupon.CouponID, eventType, &CouponEvent{
+		CouponID:   coupon.CouponID,
+		CustomerID: coupon.CustomerID,
+		Discount:   coupon.Discount,
+		ExpiryDate: &coupon.ExpiryDate,
+		OccurredAt: at,
+	})
+}
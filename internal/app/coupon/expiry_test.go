@@ -0,0 +1,95 @@
+package coupon
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeTx 트랜잭션 없이 fn을 그대로 실행
+type fakeTx struct{}
+
+func (fakeTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeRepo 쿠폰을 메모리에 보관하는 저장소
+// 인터페이스를 임베드해서 발급과 만료 처리가 쓰는 메서드만 구현한다.
+type fakeRepo struct {
+	Repository
+
+	coupons map[string]*Coupon
+	audits  []AuditEntry
+}
+
+func (r *fakeRepo) Create(ctx context.Context, coupon *Coupon) error {
+	saved := *coupon
+	r.coupons[coupon.CouponID] = &saved
+	return nil
+}
+
+func (r *fakeRepo) FindExpired(ctx context.Context, now time.Time, limit int) ([]Coupon, error) {
+	var expired []Coupon
+	for _, coupon := range r.coupons {
+		if !coupon.IsUsed && coupon.RevokedAt == nil && coupon.ExpiredAt == nil && !coupon.ExpiryDate.After(now) && len(expired) < limit {
+			expired = append(expired, *coupon)
+		}
+	}
+	return expired, nil
+}
+
+func (r *fakeRepo) MarkExpired(ctx context.Context, couponID string, expiredAt time.Time) error {
+	r.coupons[couponID].ExpiredAt = &expiredAt
+	return nil
+}
+
+func (r *fakeRepo) AddAuditEntry(ctx context.Context, entry *AuditEntry) error {
+	r.audits = append(r.audits, *entry)
+	return nil
+}
+
+// fakeRecorder 기록한 이벤트 유형을 보관
+type fakeRecorder struct {
+	events []string
+}
+
+func (r *fakeRecorder) Record(ctx context.Context, aggregateType, aggregateID, eventType string, payload interface{}) error {
+	r.events = append(r.events, eventType+":"+aggregateID)
+	return nil
+}
+
+func TestExpireCouponsIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	repo := &fakeRepo{coupons: map[string]*Coupon{
+		"expired":  {CouponID: "expired", ExpiryDate: now.Add(-time.Hour)},
+		"used":     {CouponID: "used", ExpiryDate: now.Add(-time.Hour), IsUsed: true},
+		"upcoming": {CouponID: "upcoming", ExpiryDate: now.Add(time.Hour)},
+	}}
+	recorder := &fakeRecorder{}
+	service := NewService(repo, nil, nil, nil, fakeTx{}, nil, recorder)
+
+	expired, err := service.ExpireCoupons(ctx, now)
+	if err != nil || expired != 1 {
+		t.Fatalf("first ExpireCoupons() = %d, %v, want 1, nil", expired, err)
+	}
+
+	// 같은 시각에 다시 실행해도 (리더가 바뀐 경우) 이미 만료 처리한 쿠폰은 건너뜀
+	expired, err = service.ExpireCoupons(ctx, now)
+	if err != nil || expired != 0 {
+		t.Fatalf("second ExpireCoupons() = %d, %v, want 0, nil", expired, err)
+	}
+
+	if len(recorder.events) != 1 || recorder.events[0] != "coupon.expired:expired" {
+		t.Errorf("events = %v, want a single coupon.expired for the expired coupon", recorder.events)
+	}
+
+	if len(repo.audits) != 1 || repo.audits[0].CouponID != "expired" || repo.audits[0].Action != AuditExpired {
+		t.Errorf("audits = %+v, want a single EXPIRED entry", repo.audits)
+	}
+
+	if expiredAt := repo.coupons["expired"].ExpiredAt; expiredAt == nil || !expiredAt.Equal(now) {
+		t.Errorf("expiredAt = %v, want %s", expiredAt, now)
+	}
+}
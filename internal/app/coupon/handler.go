@@ -1,8 +1,11 @@
 package coupon
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/myramen/be/internal/app/customer"
 	"github.com/myramen/be/internal/pkg/middleware"
 	"github.com/myramen/be/internal/pkg/utils/errors"
 
@@ -11,25 +14,39 @@ import (
 
 // Handler 쿠폰 핸들러
 type Handler struct {
-	service *Service
+	service   *Service
+	customers *customer.Service
 }
 
 // NewHandler 쿠폰 핸들러 생성
-func NewHandler(service *Service) *Handler {
-	return &Handler{service: service}
+func NewHandler(service *Service, customers *customer.Service) *Handler {
+	return &Handler{service: service, customers: customers}
 }
 
 // RegisterRoutes 라우트 등록
 func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
 	coupons := r.Group("/coupons")
 	{
-		coupons.GET("/:couponId", h.GetCouponByID)
+		coupons.GET("/:couponId", customer.OptionalAuth(h.customers), h.GetCouponByID)
+	}
+
+	me := r.Group("/me")
+	{
+		me.Use(customer.Auth(h.customers))
+		me.GET("/coupons", h.GetMyCoupons)
+		me.POST("/coupons/:couponId/transfer", h.TransferCoupon)
 	}
 
 	admin := r.Group("/admin")
 	{
 		admin.Use(middleware.AdminAuth())
 		admin.GET("/coupons", h.GetAllCoupons)
+		admin.POST("/coupons", h.IssueCoupon)
+		admin.GET("/coupons/:couponId/audit", h.GetAuditLog)
+		admin.POST("/coupons/:couponId/revoke", h.RevokeCoupon)
+		admin.POST("/coupons/:couponId/extend", h.ExtendCoupon)
+		admin.POST("/coupon-batches", h.IssueBatch)
+		admin.GET("/coupon-batches/:batchId", h.GetBatch)
 	}
 }
 
@@ -44,7 +61,49 @@ func (h *Handler) GetCouponByID(c *gin.Context) {
 		return
 	}
 
-	result, err := h.service.GetCouponByID(c, couponID)
+	result, err := h.service.GetCouponByID(c, couponID, customer.CurrentID(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetMyCoupons 로그인한 고객의 쿠폰 조회 핸들러
+func (h *Handler) GetMyCoupons(c *gin.Context) {
+	result, err := h.service.GetCustomerCoupons(c, customer.CurrentID(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// TransferCoupon 로그인한 고객의 쿠폰 양도 핸들러
+func (h *Handler) TransferCoupon(c *gin.Context) {
+	var req TransferCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "쿠폰 양도 요청 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.TransferCoupon(c, c.Param("couponId"), customer.CurrentID(c), req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetAuditLog 쿠폰 감사 기록 조회 핸들러 (관리자용)
+func (h *Handler) GetAuditLog(c *gin.Context) {
+	result, err := h.service.GetAuditLog(c, c.Param("couponId"))
 	if err != nil {
 		errors.HandleError(c, err)
 		return
@@ -63,3 +122,120 @@ func (h *Handler) GetAllCoupons(c *gin.Context) {
 
 	c.JSON(http.StatusOK, result)
 }
+
+// IssueCoupon 관리자 쿠폰 발급 핸들러
+func (h *Handler) IssueCoupon(c *gin.Context) {
+	var req IssueCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "쿠폰 발급 요청 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.IssueCoupon(c, req, adminActor(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// IssueBatch 관리자 쿠폰 대량 발급 핸들러 (format=csv이면 CSV 파일로 응답)
+func (h *Handler) IssueBatch(c *gin.Context) {
+	var req IssueBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "쿠폰 대량 발급 요청 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.IssueBatch(c, req, adminActor(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	h.writeBatch(c, http.StatusCreated, result)
+}
+
+// GetBatch 쿠폰 발급 묶음 조회 핸들러 (format=csv이면 CSV 파일로 응답)
+func (h *Handler) GetBatch(c *gin.Context) {
+	batchID, err := strconv.ParseInt(c.Param("batchId"), 10, 64)
+	if err != nil || batchID <= 0 {
+		errors.HandleError(c, ErrBatchNotFound)
+		return
+	}
+
+	result, err := h.service.GetBatch(c, batchID)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	h.writeBatch(c, http.StatusOK, result)
+}
+
+// RevokeCoupon 쿠폰 사용 중지 핸들러
+func (h *Handler) RevokeCoupon(c *gin.Context) {
+	var req RevokeCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "쿠폰 사용 중지 사유가 필요합니다.",
+		})
+		return
+	}
+
+	result, err := h.service.RevokeCoupon(c, c.Param("couponId"), req, adminActor(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ExtendCoupon 쿠폰 만료일 연장 핸들러
+func (h *Handler) ExtendCoupon(c *gin.Context) {
+	var req ExtendCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "쿠폰 만료일 연장 요청 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.ExtendCoupon(c, c.Param("couponId"), req, adminActor(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// writeBatch 쿠폰 발급 묶음을 JSON 또는 CSV(format=csv)로 응답
+func (h *Handler) writeBatch(c *gin.Context, status int, batch *BatchResponse) {
+	if c.Query("format") != "csv" {
+		c.JSON(status, batch)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="coupon-batch-%d.csv"`, batch.ID))
+	c.Status(status)
+	if err := WriteBatchCSV(c.Writer, batch); err != nil {
+		c.Error(err)
+	}
+}
+
+// adminActor 쿠폰 감사 기록에 남길 관리자 (주문 상태 이력과 같은 형식)
+func adminActor(c *gin.Context) string {
+	return "admin:" + middleware.AdminName(c)
+}
@@ -5,14 +5,139 @@ import (
 )
 
 type Coupon struct {
-	CouponID   string    `json:"couponId"`
-	Discount   int       `json:"discount"`
-	ExpiryDate time.Time `json:"expiryDate"`
-	IsUsed     bool      `json:"isUsed"`
-	IssuedAt   time.Time `json:"issuedAt"`
+	CouponID         string     `json:"couponId"`
+	CustomerID       int64      `json:"customerId,omitempty"` // 소유한 고객 (비로그인 주문으로 받은 쿠폰은 0)
+	OwnerKey         string     `json:"-"`                    // 비로그인 주문으로 받은 쿠폰의 소유자 (주문의 claim key)
+	BatchID          int64      `json:"batchId,omitempty"`    // 관리자 대량 발급 묶음 (대량 발급 쿠폰만)
+	CampaignID       int64      `json:"campaignId,omitempty"` // 쿠폰이 속한 캠페인 (예산과 사용 한도 적용)
+	Type             string     `json:"type"`
+	Discount         int        `json:"discount"`
+	DiscountPercent  int        `json:"discountPercent,omitempty"`
+	MaxDiscount      int        `json:"maxDiscount,omitempty"`
+	FreeOption       string     `json:"freeOption,omitempty"`
+	Conditions       Conditions `json:"conditions"`
+	ExpiryDate       time.Time  `json:"expiryDate"`
+	IsUsed           bool       `json:"isUsed"`
+	UsedByOrderID    string     `json:"usedByOrderId,omitempty"`
+	UsedAt           *time.Time `json:"usedAt,omitempty"`
+	RevokedAt        *time.Time `json:"revokedAt,omitempty"`     // 관리자가 사용을 중지한 일시
+	RevokedReason    string     `json:"revokedReason,omitempty"` // 사용 중지 사유
+	ExpiredAt        *time.Time `json:"expiredAt,omitempty"`     // 만료 처리 작업이 만료를 기록한 일시
+	ExpiryRemindedAt *time.Time `json:"-"`                       // 만료 임박 알림을 기록한 일시
+	IssuedAt         time.Time  `json:"issuedAt"`
 }
 
+// Batch 관리자가 한 번에 발급한 쿠폰 묶음 (이벤트 배포용)
+type Batch struct {
+	ID          int64     `json:"batchId"`
+	Name        string    `json:"name"`
+	CouponCount int       `json:"couponCount"`
+	CreatedBy   string    `json:"createdBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// AuditEntry 쿠폰 감사 기록 (양도, 관리자 작업 등)
+type AuditEntry struct {
+	ID        int64                  `json:"id"`
+	CouponID  string                 `json:"couponId"`
+	Action    string                 `json:"action"`
+	Actor     string                 `json:"actor"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+// 쿠폰 감사 기록 작업
+const (
+	AuditIssued      = "ISSUED"
+	AuditTransferred = "TRANSFERRED"
+	AuditRevoked     = "REVOKED"
+	AuditExtended    = "EXTENDED"
+	AuditExpired     = "EXPIRED"
+	// AuditSourceOrderCancelled 이미 사용한 구매 보상 쿠폰의 발급 주문을 관리자가 취소함 (쿠폰은 회수하지 못함)
+	AuditSourceOrderCancelled = "SOURCE_ORDER_CANCELLED"
+)
+
+// ReasonSourceOrderCancelled 발급 주문이 취소되어 구매 보상 쿠폰을 사용 중지할 때의 사유
+const ReasonSourceOrderCancelled = "발급 주문 취소"
+
+// ActorSystem 만료 처리처럼 서버가 직접 한 작업의 처리자
+const ActorSystem = "system"
+
+// 쿠폰 상태 (저장된 값이 아니라 사용, 중지 여부와 만료일로 판단, 만료 처리 작업이 돌기 전에도 만료일이 지나면 EXPIRED)
+const (
+	StatusActive  = "ACTIVE"
+	StatusUsed    = "USED"
+	StatusRevoked = "REVOKED"
+	StatusExpired = "EXPIRED"
+)
+
+// Conditions 쿠폰 사용 조건 (비어 있는 조건은 제한하지 않음)
+type Conditions struct {
+	MinOrderAmount  int      `json:"minOrderAmount,omitempty"`  // 쿠폰 할인 전 주문 금액 하한
+	DeliveryOptions []string `json:"deliveryOptions,omitempty"` // 사용할 수 있는 배달 방식
+	MenuItemIDs     []string `json:"menuItemIds,omitempty"`     // 할인 대상 라면 메뉴 ID
+}
+
+// 쿠폰 유형
+const (
+	TypeFixed      = "FIXED"       // 정액 할인 (Discount원)
+	TypePercent    = "PERCENT"     // 정률 할인 (DiscountPercent%, MaxDiscount원까지)
+	TypeFreeOption = "FREE_OPTION" // 추가 옵션(FreeOption) 무료
+)
+
 const (
 	DefaultDiscount = 200
 	ExpiryDuration  = 30 * 24 * time.Hour // 30일
 )
+
+// Status 주어진 시각의 쿠폰 상태 (사용 중지가 가장 우선)
+func (c *Coupon) Status(at time.Time) string {
+	switch {
+	case c.RevokedAt != nil:
+		return StatusRevoked
+	case c.IsUsed:
+		return StatusUsed
+	case c.ExpiredAt != nil || !at.Before(c.ExpiryDate):
+		return StatusExpired
+	default:
+		return StatusActive
+	}
+}
+
+// HasOwner 소유자가 정해진 쿠폰인지 여부 (소유자가 없는 쿠폰은 코드를 아는 누구나 사용)
+func (c *Coupon) HasOwner() bool {
+	return c.CustomerID != 0 || c.OwnerKey != ""
+}
+
+// UsableBy 주문한 고객(비로그인이면 0)과 주문의 claim key로 쿠폰을 사용할 수 있는지 여부
+// 고객 소유 쿠폰은 해당 고객만, 비로그인 주문으로 받은 쿠폰은 같은 이름과 계좌번호로 주문할 때만 사용할 수 있다.
+func (c *Coupon) UsableBy(customerID int64, claimKey string) bool {
+	if c.CustomerID != 0 {
+		return c.CustomerID == customerID
+	}
+
+	if c.OwnerKey != "" {
+		return c.OwnerKey == claimKey
+	}
+
+	return true
+}
+
+// AllowsDeliveryOption 해당 배달 방식에 쓸 수 있는 쿠폰인지 여부
+func (c Conditions) AllowsDeliveryOption(deliveryOption string) bool {
+	return len(c.DeliveryOptions) == 0 || contains(c.DeliveryOptions, deliveryOption)
+}
+
+// AllowsMenuItem 해당 메뉴가 할인 대상인지 여부
+func (c Conditions) AllowsMenuItem(menuItemID string) bool {
+	return len(c.MenuItemIDs) == 0 || contains(c.MenuItemIDs, menuItemID)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
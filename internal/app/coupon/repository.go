@@ -2,6 +2,7 @@ package coupon
 
 import (
 	"context"
+	"time"
 )
 
 // Repository 쿠폰 리포지토리 인터페이스
@@ -12,12 +13,58 @@ type Repository interface {
 	// FindByID 쿠폰 ID로 쿠폰 조회
 	FindByID(ctx context.Context, couponID string) (*Coupon, error)
 	
-	// FindAll 모든 유효한 쿠폰 조회
+	// FindAll 모든 유효한 쿠폰 조회 (미사용, 사용 중지되지 않음, 미만료)
 	FindAll(ctx context.Context) ([]Coupon, error)
+
+	// FindByIDForUpdate 쿠폰 ID로 쿠폰을 잠가서 조회 (트랜잭션 안에서 호출)
+	FindByIDForUpdate(ctx context.Context, couponID string) (*Coupon, error)
+
+	// FindByBatch 발급 묶음의 쿠폰을 발급 순으로 조회
+	FindByBatch(ctx context.Context, batchID int64) ([]Coupon, error)
+
+	// CreateBatch 쿠폰 발급 묶음 생성 (batch.ID를 채움)
+	CreateBatch(ctx context.Context, batch *Batch) error
+
+	// FindBatch 쿠폰 발급 묶음 조회
+	FindBatch(ctx context.Context, batchID int64) (*Batch, error)
+
+	// FindByCustomer 고객이 발급받은 쿠폰을 사용, 만료 여부와 관계없이 최근 발급 순으로 조회
+	FindByCustomer(ctx context.Context, customerID int64) ([]Coupon, error)
+
+	// AssignCustomer 고객이 없는 쿠폰을 고객에게 연결 (주문을 계정에 연결할 때)
+	AssignCustomer(ctx context.Context, couponID string, customerID int64) error
+
+	// ChangeOwner 미사용, 사용 중지되지 않음, 미만료이면서 fromCustomerID가 소유한 쿠폰만 toCustomerID에게 넘김 (변경 여부 반환)
+	ChangeOwner(ctx context.Context, couponID string, fromCustomerID int64, toCustomerID int64, at time.Time) (bool, error)
+
+	// AddAuditEntry 쿠폰 감사 기록 추가
+	AddAuditEntry(ctx context.Context, entry *AuditEntry) error
+
+	// FindAuditLog 쿠폰 감사 기록을 오래된 순으로 조회
+	FindAuditLog(ctx context.Context, couponID string) ([]AuditEntry, error)
 	
 	// Update 쿠폰 정보 업데이트
 	Update(ctx context.Context, coupon *Coupon) error
 	
-	// MarkAsUsed 쿠폰 사용 처리
-	MarkAsUsed(ctx context.Context, couponID string) error
+	// Redeem 미사용이면서 사용 중지, 만료되지 않은 쿠폰만 원자적으로 사용 처리
+	// 이미 사용된 쿠폰이면 ErrCouponAlreadyRedeemed, 사용 중지된 쿠폰이면 ErrCouponRevoked, 만료된 쿠폰이면 ErrCouponExpired,
+	// 존재하지 않으면 ErrCouponNotFound를 반환
+	Redeem(ctx context.Context, couponID string, orderID string, redeemedAt time.Time) error
+	
+	// Release 주문에서 사용한 쿠폰을 미사용 상태로 복원 (주문 취소 시)
+	Release(ctx context.Context, couponID string, orderID string) error
+
+	// FindExpired 만료일이 now 이전인데 아직 만료 처리하지 않은 쿠폰을 잠가서 최대 limit개 조회 (미사용, 사용 중지되지 않음)
+	// 다른 인스턴스가 잠근 행은 건너뛴다. 트랜잭션 안에서 호출해야 한다.
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]Coupon, error)
+
+	// MarkExpired 쿠폰 만료 처리 일시 기록
+	MarkExpired(ctx context.Context, couponID string, expiredAt time.Time) error
+
+	// FindExpiring now와 before 사이에 만료되는데 아직 만료 임박 알림을 보내지 않은 쿠폰을 잠가서 최대 limit개 조회 (미사용, 사용 중지되지 않음)
+	// 다른 인스턴스가 잠근 행은 건너뛴다. 트랜잭션 안에서 호출해야 한다.
+	FindExpiring(ctx context.Context, now time.Time, before time.Time, limit int) ([]Coupon, error)
+
+	// MarkReminded 만료 임박 알림 일시 기록
+	MarkReminded(ctx context.Context, couponID string, remindedAt time.Time) error
 }
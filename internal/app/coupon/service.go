@@ -2,38 +2,56 @@ package coupon
 
 import (
 	"context"
+	"strconv"
+	"time"
 
-	"github.com/myramen/be/internal/pkg/utils/errors"
+	"github.com/myramen/be/internal/app/campaign"
+	"github.com/myramen/be/internal/app/customer"
+	"github.com/myramen/be/internal/app/menu"
+	"github.com/myramen/be/internal/pkg/db"
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/idgen"
 )
 
 // Service 쿠폰 서비스
 type Service struct {
-	repo Repository
+	repo      Repository
+	customers *customer.Service
+	campaigns *campaign.Service
+	menuRepo  menu.Repository
+	tx        db.Transactor
+	ids       idgen.Generator
+	events    event.Recorder
 }
 
 // NewService 쿠폰 서비스 생성
-func NewService(repo Repository) *Service {
-	return &Service{repo: repo}
+func NewService(repo Repository, customers *customer.Service, campaigns *campaign.Service, menuRepo menu.Repository, tx db.Transactor, ids idgen.Generator, events event.Recorder) *Service {
+	return &Service{repo: repo, customers: customers, campaigns: campaigns, menuRepo: menuRepo, tx: tx, ids: ids, events: events}
 }
 
 // GetCouponByID 쿠폰 ID로 쿠폰 조회
-func (s *Service) GetCouponByID(ctx context.Context, couponID string) (*CouponResponse, error) {
+// 소유자가 있는 쿠폰은 소유한 고객에게만 보이며, 그 외에는 없는 쿠폰과 같이 응답한다.
+func (s *Service) GetCouponByID(ctx context.Context, couponID string, customerID int64) (*CouponResponse, error) {
+	couponID = idgen.NormalizeCouponID(couponID)
+	if !idgen.IsCouponID(couponID) {
+		return nil, ErrCouponNotVisible
+	}
+
 	coupon, err := s.repo.FindByID(ctx, couponID)
 	if err != nil {
 		return nil, err
 	}
 
 	if coupon == nil {
-		return nil, errors.NotFound("NOT_FOUND", "해당 쿠폰을 찾을 수 없습니다.")
+		return nil, ErrCouponNotVisible
 	}
 
-	return &CouponResponse{
-		CouponID:   coupon.CouponID,
-		Discount:   coupon.Discount,
-		ExpiryDate: coupon.ExpiryDate,
-		IsUsed:     coupon.IsUsed,
-		IssuedAt:   coupon.IssuedAt,
-	}, nil
+	if coupon.HasOwner() && (customerID == 0 || coupon.CustomerID != customerID) {
+		return nil, ErrCouponNotVisible
+	}
+
+	response := newCouponResponse(coupon)
+	return &response, nil
 }
 
 // GetAllCoupons 모든 유효한 쿠폰 조회
@@ -45,13 +63,24 @@ func (s *Service) GetAllCoupons(ctx context.Context) (*CouponListResponse, error
 
 	couponResponses := make([]CouponResponse, 0, len(coupons))
 	for _, coupon := range coupons {
-		couponResponses = append(couponResponses, CouponResponse{
-			CouponID:   coupon.CouponID,
-			Discount:   coupon.Discount,
-			ExpiryDate: coupon.ExpiryDate,
-			IsUsed:     coupon.IsUsed,
-			IssuedAt:   coupon.IssuedAt,
-		})
+		couponResponses = append(couponResponses, newCouponResponse(&coupon))
+	}
+
+	return &CouponListResponse{
+		Coupons: couponResponses,
+	}, nil
+}
+
+// GetCustomerCoupons 고객이 발급받은 쿠폰 조회 (사용, 만료된 쿠폰 포함)
+func (s *Service) GetCustomerCoupons(ctx context.Context, customerID int64) (*CouponListResponse, error) {
+	coupons, err := s.repo.FindByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	couponResponses := make([]CouponResponse, 0, len(coupons))
+	for _, coupon := range coupons {
+		couponResponses = append(couponResponses, newCouponResponse(&coupon))
 	}
 
 	return &CouponListResponse{
@@ -59,25 +88,165 @@ func (s *Service) GetAllCoupons(ctx context.Context) (*CouponListResponse, error
 	}, nil
 }
 
-// CreateCoupon 쿠폰 생성 (시스템에서만 사용)
+// CreateCoupon 쿠폰 생성과 발급 이벤트 기록 (관리자 발급도 이 경로를 사용)
 func (s *Service) CreateCoupon(ctx context.Context, coupon *Coupon) error {
-	return s.repo.Create(ctx, coupon)
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, coupon); err != nil {
+			return err
+		}
+
+		return s.events.Record(ctx, event.AggregateCoupon, coupon.CouponID, event.CouponIssued, &CouponEvent{
+			CouponID:   coupon.CouponID,
+			Discount:   coupon.Discount,
+			ExpiryDate: &coupon.ExpiryDate,
+			OccurredAt: coupon.IssuedAt,
+		})
+	})
 }
 
-// UseCoupon 쿠폰 사용 처리
-func (s *Service) UseCoupon(ctx context.Context, couponID string) error {
+// TransferCoupon 로그인한 고객이 소유한 미사용 쿠폰을 다른 고객에게 양도
+func (s *Service) TransferCoupon(ctx context.Context, couponID string, fromCustomerID int64, req TransferCouponRequest) (*TransferCouponResponse, error) {
+	couponID = idgen.NormalizeCouponID(couponID)
+	if !idgen.IsCouponID(couponID) {
+		return nil, ErrCouponNotVisible
+	}
+
+	recipient, err := s.customers.FindByEmail(ctx, req.RecipientEmail)
+	if err != nil {
+		if err == customer.ErrCustomerNotFound {
+			return nil, ErrRecipientNotFound
+		}
+		return nil, err
+	}
+
+	if recipient.ID == fromCustomerID {
+		return nil, ErrInvalidTransfer
+	}
+
+	transferredAt := time.Now()
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		coupon, err := s.repo.FindByID(ctx, couponID)
+		if err != nil {
+			return err
+		}
+
+		if coupon == nil || coupon.CustomerID != fromCustomerID {
+			return ErrCouponNotVisible
+		}
+
+		if coupon.IsUsed {
+			return ErrCouponAlreadyRedeemed
+		}
+
+		if coupon.RevokedAt != nil {
+			return ErrCouponRevoked
+		}
+
+		if !transferredAt.Before(coupon.ExpiryDate) {
+			return ErrCouponExpired
+		}
+
+		changed, err := s.repo.ChangeOwner(ctx, couponID, fromCustomerID, recipient.ID, transferredAt)
+		if err != nil {
+			return err
+		}
+
+		if !changed {
+			return ErrCouponStateChanged
+		}
+
+		details := map[string]interface{}{
+			"fromCustomerId": fromCustomerID,
+			"toCustomerId":   recipient.ID,
+		}
+		if req.Note != "" {
+			details["note"] = req.Note
+		}
+
+		if err := s.repo.AddAuditEntry(ctx, &AuditEntry{
+			CouponID:  couponID,
+			Action:    AuditTransferred,
+			Actor:     customerActor(fromCustomerID),
+			Details:   details,
+			CreatedAt: transferredAt,
+		}); err != nil {
+			return err
+		}
+
+		return s.events.Record(ctx, event.AggregateCoupon, couponID, event.CouponTransferred, &CouponEvent{
+			CouponID:       couponID,
+			Discount:       coupon.Discount,
+			FromCustomerID: fromCustomerID,
+			ToCustomerID:   recipient.ID,
+			OccurredAt:     transferredAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransferCouponResponse{
+		CouponID:       couponID,
+		RecipientEmail: recipient.Email,
+		TransferredAt:  transferredAt,
+	}, nil
+}
+
+// GetAuditLog 쿠폰 감사 기록 조회 (관리자용)
+func (s *Service) GetAuditLog(ctx context.Context, couponID string) (*AuditLogResponse, error) {
+	couponID = idgen.NormalizeCouponID(couponID)
+	if !idgen.IsCouponID(couponID) {
+		return nil, ErrCouponNotVisible
+	}
+
 	coupon, err := s.repo.FindByID(ctx, couponID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if coupon == nil {
-		return errors.NotFound("NOT_FOUND", "해당 쿠폰을 찾을 수 없습니다.")
+		return nil, ErrCouponNotVisible
 	}
 
-	if coupon.IsUsed {
-		return errors.BadRequest("INVALID_COUPON", "이미 사용된 쿠폰입니다.")
+	entries, err := s.repo.FindAuditLog(ctx, couponID)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.repo.MarkAsUsed(ctx, couponID)
+	if entries == nil {
+		entries = []AuditEntry{}
+	}
+
+	return &AuditLogResponse{
+		CouponID: couponID,
+		Entries:  entries,
+	}, nil
+}
+
+// customerActor 감사 기록에 남길 고객 작업자 표기
+func customerActor(customerID int64) string {
+	return "customer:" + strconv.FormatInt(customerID, 10)
+}
+
+// newCouponResponse 쿠폰 응답 생성
+func newCouponResponse(coupon *Coupon) CouponResponse {
+	return CouponResponse{
+		CouponID:        coupon.CouponID,
+		Type:            coupon.Type,
+		Discount:        coupon.Discount,
+		DiscountPercent: coupon.DiscountPercent,
+		MaxDiscount:     coupon.MaxDiscount,
+		FreeOption:      coupon.FreeOption,
+		Conditions:      coupon.Conditions,
+		ExpiryDate:      coupon.ExpiryDate,
+		IsUsed:          coupon.IsUsed,
+		UsedAt:          coupon.UsedAt,
+		Status:          coupon.Status(time.Now()),
+		RevokedAt:       coupon.RevokedAt,
+		RevokedReason:   coupon.RevokedReason,
+		ExpiredAt:       coupon.ExpiredAt,
+		BatchID:         coupon.BatchID,
+		CampaignID:      coupon.CampaignID,
+		IssuedAt:        coupon.IssuedAt,
+	}
 }
@@ -0,0 +1,32 @@
+package customer
+
+import (
+	"time"
+)
+
+// SignUpRequest 회원가입 요청 DTO
+type SignUpRequest struct {
+	Email    string `json:"email" binding:"required,email,max=255"`
+	Name     string `json:"name" binding:"required,max=100"`
+	Password string `json:"password" binding:"required,min=8,max=72"`
+}
+
+// LoginRequest 로그인 요청 DTO
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// AuthResponse 회원가입, 로그인 응답 DTO
+type AuthResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Customer  *Customer `json:"customer"`
+}
+
+// ErrorResponse 에러 응답 DTO
+type ErrorResponse struct {
+	Error   string      `json:"error"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
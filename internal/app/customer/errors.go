@@ -0,0 +1,15 @@
+package customer
+
+import (
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 고객 계정 관련 에러
+var (
+	ErrCustomerNotFound   = errors.NotFound("NOT_FOUND", "해당 고객을 찾을 수 없습니다.")
+	ErrEmailTaken         = errors.Conflict("EMAIL_TAKEN", "이미 가입된 이메일입니다.")
+	ErrInvalidCredentials = errors.Unauthorized("INVALID_CREDENTIALS", "이메일 또는 비밀번호가 올바르지 않습니다.")
+	ErrLoginRequired      = errors.Unauthorized("LOGIN_REQUIRED", "로그인이 필요합니다.")
+	ErrInvalidSession     = errors.Unauthorized("INVALID_SESSION", "로그인 세션이 만료되었거나 유효하지 않습니다.")
+	ErrPasswordTooLong    = errors.BadRequest("INVALID_REQUEST", "비밀번호는 72바이트 이하여야 합니다.")
+)
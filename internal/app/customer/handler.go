@@ -0,0 +1,96 @@
+package customer
+
+import (
+	"net/http"
+
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 고객 핸들러
+type Handler struct {
+	service *Service
+}
+
+// NewHandler 고객 핸들러 생성
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes 라우트 등록
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	customers := r.Group("/customers")
+	{
+		customers.POST("/signup", h.SignUp)
+		customers.POST("/login", h.Login)
+		customers.POST("/logout", Auth(h.service), h.Logout)
+	}
+
+	me := r.Group("/me")
+	{
+		me.Use(Auth(h.service))
+		me.GET("", h.GetMe)
+	}
+}
+
+// SignUp 회원가입 핸들러
+func (h *Handler) SignUp(c *gin.Context) {
+	var req SignUpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "회원가입 정보가 유효하지 않습니다. (비밀번호는 8자 이상)",
+		})
+		return
+	}
+
+	result, err := h.service.SignUp(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// Login 로그인 핸들러
+func (h *Handler) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "이메일과 비밀번호가 필요합니다.",
+		})
+		return
+	}
+
+	result, err := h.service.Login(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Logout 로그아웃 핸들러
+func (h *Handler) Logout(c *gin.Context) {
+	if err := h.service.Logout(c, Token(c)); err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetMe 로그인한 고객 정보 조회 핸들러
+func (h *Handler) GetMe(c *gin.Context) {
+	result, err := h.service.GetCustomer(c, CurrentID(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,70 @@
+package customer
+
+import (
+	"strings"
+
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// customerIDKey 컨텍스트에 로그인한 고객 ID를 저장하는 키
+const customerIDKey = "customerID"
+
+// Auth 로그인한 고객만 허용하는 미들웨어 (Authorization: Bearer <token>)
+func Auth(service *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := bearerToken(c)
+		if token == "" {
+			errors.HandleError(c, ErrLoginRequired)
+			c.Abort()
+			return
+		}
+
+		authenticate(c, service, token)
+	}
+}
+
+// OptionalAuth 토큰이 있으면 고객을 확인하고, 없으면 비로그인 요청으로 통과시키는 미들웨어
+// 토큰을 보냈는데 유효하지 않으면 비로그인으로 처리하지 않고 거절한다.
+func OptionalAuth(service *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := bearerToken(c)
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		authenticate(c, service, token)
+	}
+}
+
+// CurrentID 로그인한 고객 ID (비로그인 요청이면 0)
+func CurrentID(c *gin.Context) int64 {
+	return c.GetInt64(customerIDKey)
+}
+
+// Token 요청의 세션 토큰
+func Token(c *gin.Context) string {
+	return bearerToken(c)
+}
+
+func authenticate(c *gin.Context, service *Service, token string) {
+	customer, err := service.Authenticate(c, token)
+	if err != nil {
+		errors.HandleError(c, err)
+		c.Abort()
+		return
+	}
+
+	c.Set(customerIDKey, customer.ID)
+	c.Next()
+}
+
+func bearerToken(c *gin.Context) string {
+	scheme, token, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
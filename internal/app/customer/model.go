@@ -0,0 +1,23 @@
+package customer
+
+import (
+	"time"
+)
+
+// Customer 고객 계정
+type Customer struct {
+	ID           int64     `json:"customerId"`
+	Email        string    `json:"email"`
+	Name         string    `json:"name"`
+	PasswordHash string    `json:"-"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// Session 로그인 세션 (토큰 원문은 발급 응답에서만 반환하고 해시만 저장)
+type Session struct {
+	TokenHash  string
+	CustomerID int64
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
@@ -0,0 +1,26 @@
+package customer
+
+import (
+	"context"
+)
+
+// Repository 고객 리포지토리 인터페이스
+type Repository interface {
+	// Create 고객 생성 (이메일이 이미 있으면 ErrEmailTaken)
+	Create(ctx context.Context, customer *Customer) error
+
+	// FindByID 고객 ID로 조회 (없으면 nil)
+	FindByID(ctx context.Context, id int64) (*Customer, error)
+
+	// FindByEmail 이메일로 조회 (없으면 nil)
+	FindByEmail(ctx context.Context, email string) (*Customer, error)
+
+	// CreateSession 로그인 세션 저장
+	CreateSession(ctx context.Context, session *Session) error
+
+	// FindSession 토큰 해시로 세션 조회 (없으면 nil)
+	FindSession(ctx context.Context, tokenHash string) (*Session, error)
+
+	// DeleteSession 세션 삭제
+	DeleteSession(ctx context.Context, tokenHash string) error
+}
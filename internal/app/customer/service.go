@@ -0,0 +1,160 @@
+package customer
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/idgen"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// maxPasswordBytes bcrypt가 사용하는 최대 비밀번호 길이
+const maxPasswordBytes = 72
+
+// dummyPasswordHash 없는 이메일로 로그인해도 비밀번호 비교 시간이 같도록 사용하는 해시
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("myramen-dummy-password"), bcrypt.DefaultCost)
+
+// Service 고객 서비스
+type Service struct {
+	repo       Repository
+	sessionTTL time.Duration
+}
+
+// NewService 고객 서비스 생성
+func NewService(repo Repository, sessionTTL time.Duration) *Service {
+	return &Service{repo: repo, sessionTTL: sessionTTL}
+}
+
+// SignUp 회원가입 후 바로 로그인 세션 발급
+func (s *Service) SignUp(ctx context.Context, req SignUpRequest) (*AuthResponse, error) {
+	if len(req.Password) > maxPasswordBytes {
+		return nil, ErrPasswordTooLong
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "비밀번호를 처리하는데 실패했습니다.")
+	}
+
+	now := time.Now()
+	customer := &Customer{
+		Email:        normalizeEmail(req.Email),
+		Name:         strings.TrimSpace(req.Name),
+		PasswordHash: string(passwordHash),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := s.repo.Create(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	return s.newSession(ctx, customer)
+}
+
+// Login 이메일과 비밀번호로 로그인 세션 발급
+func (s *Service) Login(ctx context.Context, req LoginRequest) (*AuthResponse, error) {
+	customer, err := s.repo.FindByEmail(ctx, normalizeEmail(req.Email))
+	if err != nil {
+		return nil, err
+	}
+
+	// 가입 여부를 응답 시간으로 알 수 없도록 없는 계정도 같은 비교를 수행
+	passwordHash := dummyPasswordHash
+	if customer != nil {
+		passwordHash = []byte(customer.PasswordHash)
+	}
+
+	if bcrypt.CompareHashAndPassword(passwordHash, []byte(req.Password)) != nil || customer == nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.newSession(ctx, customer)
+}
+
+// Logout 로그인 세션 삭제
+func (s *Service) Logout(ctx context.Context, token string) error {
+	return s.repo.DeleteSession(ctx, idgen.HashToken(token))
+}
+
+// Authenticate 세션 토큰으로 로그인한 고객 확인
+func (s *Service) Authenticate(ctx context.Context, token string) (*Customer, error) {
+	session, err := s.repo.FindSession(ctx, idgen.HashToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if session == nil || !time.Now().Before(session.ExpiresAt) {
+		return nil, ErrInvalidSession
+	}
+
+	customer, err := s.repo.FindByID(ctx, session.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if customer == nil {
+		return nil, ErrInvalidSession
+	}
+
+	return customer, nil
+}
+
+// GetCustomer 고객 정보 조회
+func (s *Service) GetCustomer(ctx context.Context, id int64) (*Customer, error) {
+	customer, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if customer == nil {
+		return nil, ErrCustomerNotFound
+	}
+
+	return customer, nil
+}
+
+// FindByEmail 이메일로 고객 조회
+func (s *Service) FindByEmail(ctx context.Context, email string) (*Customer, error) {
+	customer, err := s.repo.FindByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		return nil, err
+	}
+
+	if customer == nil {
+		return nil, ErrCustomerNotFound
+	}
+
+	return customer, nil
+}
+
+// newSession 새 로그인 세션 발급 (토큰 원문은 이번 응답에서만 반환)
+func (s *Service) newSession(ctx context.Context, customer *Customer) (*AuthResponse, error) {
+	token := idgen.NewToken()
+	now := time.Now()
+
+	session := &Session{
+		TokenHash:  idgen.HashToken(token),
+		CustomerID: customer.ID,
+		ExpiresAt:  now.Add(s.sessionTTL),
+		CreatedAt:  now,
+	}
+
+	if err := s.repo.CreateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &AuthResponse{
+		Token:     token,
+		ExpiresAt: session.ExpiresAt,
+		Customer:  customer,
+	}, nil
+}
+
+// normalizeEmail 이메일 비교용 정규화 (앞뒤 공백 제거, 소문자)
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,77 @@
+package inventory
+
+// CreateItemRequest 재고 품목 생성 요청 DTO
+type CreateItemRequest struct {
+	SKU               string `json:"sku" binding:"required"`
+	Name              string `json:"name" binding:"required,max=100"`
+	Quantity          int    `json:"quantity" binding:"min=0"`
+	LowStockThreshold int    `json:"lowStockThreshold" binding:"min=0"`
+}
+
+// UpdateItemRequest 재고 품목 수정 요청 DTO (전달한 항목만 변경, 수량은 입고/조정으로만 변경)
+type UpdateItemRequest struct {
+	Name              *string `json:"name,omitempty" binding:"omitempty,min=1,max=100"`
+	LowStockThreshold *int    `json:"lowStockThreshold,omitempty" binding:"omitempty,min=0"`
+}
+
+// RestockRequest 입고 요청 DTO
+type RestockRequest struct {
+	Quantity int    `json:"quantity" binding:"required,min=1"`
+	Note     string `json:"note,omitempty" binding:"max=255"`
+}
+
+// AdjustRequest 재고 조정 요청 DTO (파손, 분실 등은 음수)
+type AdjustRequest struct {
+	Delta int    `json:"delta" binding:"required"`
+	Note  string `json:"note" binding:"required,max=255"`
+}
+
+// ListLedgerRequest 재고 변동 기록 조회 요청 DTO
+type ListLedgerRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+// SetUsagesRequest 메뉴 재고 소모량 설정 요청 DTO (빈 목록이면 재고를 관리하지 않음)
+type SetUsagesRequest struct {
+	Usages []UsageRequest `json:"usages" binding:"max=20,dive"`
+}
+
+// UsageRequest 메뉴 한 개당 소모하는 재고 DTO
+type UsageRequest struct {
+	SKU      string `json:"sku" binding:"required"`
+	Quantity int    `json:"quantity" binding:"required,min=1"`
+}
+
+// ListUsagesRequest 메뉴 재고 소모량 조회 요청 DTO
+type ListUsagesRequest struct {
+	MenuItemID string `form:"menuItemId"`
+}
+
+// StockItemResponse 재고 품목 응답 DTO
+type StockItemResponse struct {
+	StockItem
+	LowStock bool `json:"lowStock"`
+}
+
+// StockItemListResponse 재고 품목 목록 응답 DTO
+type StockItemListResponse struct {
+	Items []StockItemResponse `json:"items"`
+}
+
+// LedgerResponse 재고 변동 기록 응답 DTO
+type LedgerResponse struct {
+	SKU     string        `json:"sku"`
+	Entries []LedgerEntry `json:"entries"`
+}
+
+// UsageListResponse 메뉴 재고 소모량 응답 DTO
+type UsageListResponse struct {
+	Usages []Usage `json:"usages"`
+}
+
+// ErrorResponse 에러 응답 DTO
+type ErrorResponse struct {
+	Error   string      `json:"error"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
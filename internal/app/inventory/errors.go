@@ -0,0 +1,16 @@
+package inventory
+
+import (
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 재고 관련 에러
+var (
+	ErrStockItemNotFound = errors.NotFound("NOT_FOUND", "해당 재고 품목을 찾을 수 없습니다.")
+	ErrMenuItemNotFound  = errors.NotFound("NOT_FOUND", "해당 메뉴를 찾을 수 없습니다.")
+	ErrStockItemExists   = errors.Conflict("STOCK_ITEM_EXISTS", "이미 같은 SKU의 재고 품목이 있습니다.")
+	ErrInvalidSKU        = errors.BadRequest("INVALID_REQUEST", "SKU는 영문 소문자, 숫자, 밑줄(_)로 된 2~50자여야 합니다.")
+	ErrInvalidAdjustment = errors.BadRequest("INVALID_ADJUSTMENT", "재고를 0개 미만으로 조정할 수 없습니다.")
+	ErrUnknownUsageSKU   = errors.BadRequest("INVALID_REQUEST", "등록되지 않은 SKU가 포함되어 있습니다.")
+	ErrDuplicateUsageSKU = errors.BadRequest("INVALID_REQUEST", "같은 SKU를 여러 번 지정할 수 없습니다.")
+)
@@ -0,0 +1,15 @@
+package inventory
+
+import (
+	"time"
+)
+
+// LowStockEvent 재고가 부족 기준 이하로 떨어졌을 때의 이벤트
+type LowStockEvent struct {
+	SKU        string    `json:"sku"`
+	Name       string    `json:"name"`
+	Quantity   int       `json:"quantity"`
+	Threshold  int       `json:"lowStockThreshold"`
+	OrderID    string    `json:"orderId,omitempty"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
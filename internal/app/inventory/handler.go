@@ -0,0 +1,204 @@
+package inventory
+
+import (
+	"net/http"
+
+	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 재고 핸들러
+type Handler struct {
+	service *Service
+}
+
+// NewHandler 재고 핸들러 생성
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes 라우트 등록
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	admin := r.Group("/admin")
+	{
+		admin.Use(middleware.AdminAuth())
+		admin.GET("/inventory", h.GetItems)
+		admin.POST("/inventory", h.CreateItem)
+		admin.GET("/inventory/:sku", h.GetItem)
+		admin.PATCH("/inventory/:sku", h.UpdateItem)
+		admin.POST("/inventory/:sku/restock", h.Restock)
+		admin.POST("/inventory/:sku/adjust", h.Adjust)
+		admin.GET("/inventory/:sku/ledger", h.GetLedger)
+		admin.GET("/inventory-usages", h.GetUsages)
+		admin.PUT("/inventory-usages/:menuItemId", h.SetUsages)
+	}
+}
+
+// GetItems 재고 품목 목록 조회 핸들러
+func (h *Handler) GetItems(c *gin.Context) {
+	result, err := h.service.GetItems(c)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CreateItem 재고 품목 생성 핸들러
+func (h *Handler) CreateItem(c *gin.Context) {
+	var req CreateItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "재고 품목 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.CreateItem(c, req, adminActor(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetItem 재고 품목 조회 핸들러
+func (h *Handler) GetItem(c *gin.Context) {
+	result, err := h.service.GetItem(c, c.Param("sku"))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// UpdateItem 재고 품목 수정 핸들러
+func (h *Handler) UpdateItem(c *gin.Context) {
+	var req UpdateItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "재고 품목 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.UpdateItem(c, c.Param("sku"), req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Restock 입고 핸들러
+func (h *Handler) Restock(c *gin.Context) {
+	var req RestockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "입고 수량이 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.Restock(c, c.Param("sku"), req, adminActor(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Adjust 재고 조정 핸들러
+func (h *Handler) Adjust(c *gin.Context) {
+	var req AdjustRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "재고 조정 정보가 유효하지 않습니다. (delta는 0이 아닌 정수, note는 필수)",
+		})
+		return
+	}
+
+	result, err := h.service.Adjust(c, c.Param("sku"), req, adminActor(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetLedger 재고 변동 기록 조회 핸들러
+func (h *Handler) GetLedger(c *gin.Context) {
+	var req ListLedgerRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "재고 변동 기록 조회 조건이 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.GetLedger(c, c.Param("sku"), req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetUsages 메뉴 재고 소모량 조회 핸들러
+func (h *Handler) GetUsages(c *gin.Context) {
+	var req ListUsagesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "재고 소모량 조회 조건이 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.GetUsages(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// SetUsages 메뉴 재고 소모량 설정 핸들러
+func (h *Handler) SetUsages(c *gin.Context) {
+	var req SetUsagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "재고 소모량 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.SetUsages(c, c.Param("menuItemId"), req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// adminActor 재고 변동 기록에 남길 관리자 (주문 상태 이력과 같은 형식)
+func adminActor(c *gin.Context) string {
+	return "admin:" + middleware.AdminName(c)
+}
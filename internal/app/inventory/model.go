@@ -0,0 +1,48 @@
+package inventory
+
+import (
+	"time"
+)
+
+// StockItem 재고 품목 (라면 봉지, 뜨거운 물 컵 같은 소모품 포함)
+type StockItem struct {
+	SKU               string    `json:"sku"`
+	Name              string    `json:"name"`
+	Quantity          int       `json:"quantity"`
+	LowStockThreshold int       `json:"lowStockThreshold"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+// IsLow 재고가 부족 기준 이하인지 여부
+func (i *StockItem) IsLow() bool {
+	return i.Quantity <= i.LowStockThreshold
+}
+
+// Usage 메뉴 한 개를 팔 때 소모하는 재고
+type Usage struct {
+	MenuItemID string `json:"menuItemId"`
+	SKU        string `json:"sku"`
+	Quantity   int    `json:"quantity"`
+}
+
+// LedgerEntry 재고 변동 기록
+type LedgerEntry struct {
+	ID        int64     `json:"id"`
+	SKU       string    `json:"sku"`
+	Delta     int       `json:"delta"`
+	Balance   int       `json:"balance"`
+	Reason    string    `json:"reason"`
+	OrderID   string    `json:"orderId,omitempty"`
+	Actor     string    `json:"actor"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// 재고 변동 사유
+const (
+	ReasonOrder   = "ORDER"
+	ReasonCancel  = "CANCEL"
+	ReasonRestock = "RESTOCK"
+	ReasonAdjust  = "ADJUST"
+)
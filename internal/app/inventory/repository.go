@@ -0,0 +1,39 @@
+package inventory
+
+import (
+	"context"
+	"time"
+)
+
+// Repository 재고 리포지토리 인터페이스
+type Repository interface {
+	// CreateItem 재고 품목 생성
+	CreateItem(ctx context.Context, item *StockItem) error
+
+	// FindItem SKU로 재고 품목 조회 (없으면 nil)
+	FindItem(ctx context.Context, sku string) (*StockItem, error)
+
+	// FindItems 재고 품목을 SKU 순으로 조회 (skus가 비어 있으면 전체)
+	FindItems(ctx context.Context, skus []string) ([]StockItem, error)
+
+	// UpdateItem 재고 품목 이름과 부족 기준 수정
+	UpdateItem(ctx context.Context, item *StockItem) error
+
+	// AddQuantity 재고 수량 증감 (결과가 0 미만이 되면 변경하지 않고 false)
+	AddQuantity(ctx context.Context, sku string, delta int, updatedAt time.Time) (bool, error)
+
+	// AddLedgerEntry 재고 변동 기록
+	AddLedgerEntry(ctx context.Context, entry *LedgerEntry) error
+
+	// FindLedger 재고 품목의 변동 기록을 최신순으로 limit개까지 조회
+	FindLedger(ctx context.Context, sku string, limit int) ([]LedgerEntry, error)
+
+	// FindOrderLedger 주문으로 생긴 재고 변동 기록을 오래된 순으로 조회
+	FindOrderLedger(ctx context.Context, orderID string) ([]LedgerEntry, error)
+
+	// FindUsages 메뉴별 재고 소모량 조회 (menuItemIDs가 비어 있으면 전체)
+	FindUsages(ctx context.Context, menuItemIDs []string) ([]Usage, error)
+
+	// ReplaceUsages 메뉴의 재고 소모량을 전달한 목록으로 교체
+	ReplaceUsages(ctx context.Context, menuItemID string, usages []Usage) error
+}
@@ -0,0 +1,525 @@
+package inventory
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/db"
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// DefaultLedgerLimit 재고 변동 기록 기본 조회 개수
+const DefaultLedgerLimit = 50
+
+// skuPattern 재고 품목 SKU 형식 (예: shin_ramyun_packet)
+var skuPattern = regexp.MustCompile(`^[a-z0-9_]{2,50}$`)
+
+// Service 재고 서비스
+type Service struct {
+	repo   Repository
+	tx     db.Transactor
+	events event.Recorder
+}
+
+// NewService 재고 서비스 생성
+func NewService(repo Repository, tx db.Transactor, events event.Recorder) *Service {
+	return &Service{
+		repo:   repo,
+		tx:     tx,
+		events: events,
+	}
+}
+
+// GetItems 전체 재고 품목 조회
+func (s *Service) GetItems(ctx context.Context) (*StockItemListResponse, error) {
+	items, err := s.repo.FindItems(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]StockItemResponse, 0, len(items))
+	for i := range items {
+		responses = append(responses, newStockItemResponse(&items[i]))
+	}
+
+	return &StockItemListResponse{Items: responses}, nil
+}
+
+// GetItem 재고 품목 조회
+func (s *Service) GetItem(ctx context.Context, sku string) (*StockItemResponse, error) {
+	item, err := s.findItem(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newStockItemResponse(item)
+	return &response, nil
+}
+
+// CreateItem 재고 품목 생성 (초기 수량은 입고로 기록)
+func (s *Service) CreateItem(ctx context.Context, req CreateItemRequest, actor string) (*StockItemResponse, error) {
+	if !skuPattern.MatchString(req.SKU) {
+		return nil, ErrInvalidSKU
+	}
+
+	now := time.Now()
+	item := &StockItem{
+		SKU:               req.SKU,
+		Name:              req.Name,
+		LowStockThreshold: req.LowStockThreshold,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.CreateItem(ctx, item); err != nil {
+			return err
+		}
+
+		if req.Quantity == 0 {
+			return nil
+		}
+
+		updated, err := s.change(ctx, item.SKU, req.Quantity, ReasonRestock, "", actor, "초기 재고")
+		if err != nil {
+			return err
+		}
+
+		item = updated
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := newStockItemResponse(item)
+	return &response, nil
+}
+
+// UpdateItem 재고 품목 이름과 부족 기준 수정
+func (s *Service) UpdateItem(ctx context.Context, sku string, req UpdateItemRequest) (*StockItemResponse, error) {
+	item, err := s.findItem(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		item.Name = *req.Name
+	}
+
+	if req.LowStockThreshold != nil {
+		item.LowStockThreshold = *req.LowStockThreshold
+	}
+
+	item.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateItem(ctx, item); err != nil {
+		return nil, err
+	}
+
+	response := newStockItemResponse(item)
+	return &response, nil
+}
+
+// Restock 입고 처리
+func (s *Service) Restock(ctx context.Context, sku string, req RestockRequest, actor string) (*StockItemResponse, error) {
+	return s.adjust(ctx, sku, req.Quantity, ReasonRestock, req.Note, actor)
+}
+
+// Adjust 실사, 파손, 분실 등으로 재고 수량 조정
+func (s *Service) Adjust(ctx context.Context, sku string, req AdjustRequest, actor string) (*StockItemResponse, error) {
+	return s.adjust(ctx, sku, req.Delta, ReasonAdjust, req.Note, actor)
+}
+
+// GetLedger 재고 품목의 변동 기록을 최신순으로 조회
+func (s *Service) GetLedger(ctx context.Context, sku string, req ListLedgerRequest) (*LedgerResponse, error) {
+	if _, err := s.findItem(ctx, sku); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultLedgerLimit
+	}
+
+	entries, err := s.repo.FindLedger(ctx, sku, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if entries == nil {
+		entries = []LedgerEntry{}
+	}
+
+	return &LedgerResponse{SKU: sku, Entries: entries}, nil
+}
+
+// GetUsages 메뉴별 재고 소모량 조회
+func (s *Service) GetUsages(ctx context.Context, req ListUsagesRequest) (*UsageListResponse, error) {
+	var menuItemIDs []string
+	if req.MenuItemID != "" {
+		menuItemIDs = []string{req.MenuItemID}
+	}
+
+	usages, err := s.repo.FindUsages(ctx, menuItemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if usages == nil {
+		usages = []Usage{}
+	}
+
+	return &UsageListResponse{Usages: usages}, nil
+}
+
+// SetUsages 메뉴 한 개를 팔 때 소모하는 재고 설정 (소모량이 없는 메뉴는 재고를 확인하지 않음)
+func (s *Service) SetUsages(ctx context.Context, menuItemID string, req SetUsagesRequest) (*UsageListResponse, error) {
+	// 메뉴 ID는 SKU와 형식이 같음
+	if !skuPattern.MatchString(menuItemID) {
+		return nil, ErrMenuItemNotFound
+	}
+
+	usages := make([]Usage, 0, len(req.Usages))
+	skus := make([]string, 0, len(req.Usages))
+	seen := make(map[string]bool, len(req.Usages))
+
+	for _, usage := range req.Usages {
+		if seen[usage.SKU] {
+			return nil, ErrDuplicateUsageSKU
+		}
+		seen[usage.SKU] = true
+
+		skus = append(skus, usage.SKU)
+		usages = append(usages, Usage{
+			MenuItemID: menuItemID,
+			SKU:        usage.SKU,
+			Quantity:   usage.Quantity,
+		})
+	}
+
+	if len(skus) > 0 {
+		items, err := s.repo.FindItems(ctx, skus)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(items) != len(skus) {
+			return nil, ErrUnknownUsageSKU
+		}
+	}
+
+	// 기존 소모량 삭제와 새 소모량 저장을 하나의 트랜잭션으로 처리
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return s.repo.ReplaceUsages(ctx, menuItemID, usages)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsageListResponse{Usages: usages}, nil
+}
+
+// Reserve 주문한 메뉴 수량(메뉴 ID별)만큼 재고 차감
+// 주문 생성 트랜잭션 안에서 호출하며, 하나라도 부족하면 OUT_OF_STOCK 에러를 반환한다.
+func (s *Service) Reserve(ctx context.Context, orderID string, units map[string]int, actor string) error {
+	required, err := s.requiredStock(ctx, units)
+	if err != nil {
+		return err
+	}
+
+	if len(required) == 0 {
+		return nil
+	}
+
+	// 동시 주문끼리 교착 상태가 생기지 않도록 SKU 순서대로 차감
+	skus := make([]string, 0, len(required))
+	for sku := range required {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		for _, sku := range skus {
+			item, err := s.change(ctx, sku, -required[sku], ReasonOrder, orderID, actor, "")
+			if err != nil {
+				return err
+			}
+
+			if item == nil {
+				return s.outOfStock(ctx, sku, required[sku])
+			}
+		}
+
+		return nil
+	})
+}
+
+// Check 주문한 메뉴 수량만큼 재고가 있는지 확인 (차감하지 않음, 주문 견적용)
+// 부족하면 Reserve와 같은 OUT_OF_STOCK 에러를 반환한다.
+func (s *Service) Check(ctx context.Context, units map[string]int) error {
+	required, err := s.requiredStock(ctx, units)
+	if err != nil {
+		return err
+	}
+
+	if len(required) == 0 {
+		return nil
+	}
+
+	skus := make([]string, 0, len(required))
+	for sku := range required {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	items, err := s.repo.FindItems(ctx, skus)
+	if err != nil {
+		return err
+	}
+
+	stock := make(map[string]int, len(items))
+	for _, item := range items {
+		stock[item.SKU] = item.Quantity
+	}
+
+	for _, sku := range skus {
+		if stock[sku] < required[sku] {
+			return s.outOfStock(ctx, sku, required[sku])
+		}
+	}
+
+	return nil
+}
+
+// Release 주문으로 차감한 재고를 되돌림 (주문 취소 트랜잭션 안에서 호출)
+func (s *Service) Release(ctx context.Context, orderID string, actor string) error {
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		entries, err := s.repo.FindOrderLedger(ctx, orderID)
+		if err != nil {
+			return err
+		}
+
+		// 아직 되돌리지 않은 수량만 복원
+		remaining := make(map[string]int)
+		var skus []string
+		for _, entry := range entries {
+			if _, ok := remaining[entry.SKU]; !ok {
+				skus = append(skus, entry.SKU)
+			}
+			remaining[entry.SKU] -= entry.Delta
+		}
+		sort.Strings(skus)
+
+		for _, sku := range skus {
+			if remaining[sku] <= 0 {
+				continue
+			}
+
+			if _, err := s.change(ctx, sku, remaining[sku], ReasonCancel, orderID, actor, ""); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// SoldOutMenuItems 재고가 부족해서 한 개도 팔 수 없는 메뉴 ID 목록
+func (s *Service) SoldOutMenuItems(ctx context.Context, menuItemIDs []string) (map[string]bool, error) {
+	soldOut := make(map[string]bool)
+	if len(menuItemIDs) == 0 {
+		return soldOut, nil
+	}
+
+	usages, err := s.repo.FindUsages(ctx, menuItemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(usages) == 0 {
+		return soldOut, nil
+	}
+
+	skus := make([]string, 0, len(usages))
+	for _, usage := range usages {
+		skus = append(skus, usage.SKU)
+	}
+
+	items, err := s.repo.FindItems(ctx, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	stock := make(map[string]int, len(items))
+	for _, item := range items {
+		stock[item.SKU] = item.Quantity
+	}
+
+	for _, usage := range usages {
+		if stock[usage.SKU] < usage.Quantity {
+			soldOut[usage.MenuItemID] = true
+		}
+	}
+
+	return soldOut, nil
+}
+
+// requiredStock 메뉴 ID별 수량을 SKU별 필요 재고로 변환
+func (s *Service) requiredStock(ctx context.Context, units map[string]int) (map[string]int, error) {
+	menuItemIDs := make([]string, 0, len(units))
+	for menuItemID := range units {
+		menuItemIDs = append(menuItemIDs, menuItemID)
+	}
+
+	if len(menuItemIDs) == 0 {
+		return nil, nil
+	}
+
+	usages, err := s.repo.FindUsages(ctx, menuItemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]int)
+	for _, usage := range usages {
+		required[usage.SKU] += usage.Quantity * units[usage.MenuItemID]
+	}
+
+	return required, nil
+}
+
+// adjust 입고나 조정을 한 트랜잭션으로 처리
+func (s *Service) adjust(ctx context.Context, sku string, delta int, reason string, note string, actor string) (*StockItemResponse, error) {
+	if _, err := s.findItem(ctx, sku); err != nil {
+		return nil, err
+	}
+
+	var item *StockItem
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		item, err = s.change(ctx, sku, delta, reason, "", actor, note)
+		if err != nil {
+			return err
+		}
+
+		if item == nil {
+			return ErrInvalidAdjustment
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := newStockItemResponse(item)
+	return &response, nil
+}
+
+// change 재고 수량을 바꾸고 변동 기록을 남김 (트랜잭션 안에서 호출)
+// 수량이 0 미만이 되면 변경하지 않고 nil을 반환한다. 부족 기준 이하로 떨어지면 이벤트를 기록한다.
+func (s *Service) change(ctx context.Context, sku string, delta int, reason string, orderID string, actor string, note string) (*StockItem, error) {
+	now := time.Now()
+
+	applied, err := s.repo.AddQuantity(ctx, sku, delta, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if !applied {
+		return nil, nil
+	}
+
+	// 같은 트랜잭션에서 잠근 행이므로 변경 직후 수량을 읽을 수 있음
+	item, err := s.repo.FindItem(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		return nil, ErrStockItemNotFound
+	}
+
+	if err := s.repo.AddLedgerEntry(ctx, &LedgerEntry{
+		SKU:       sku,
+		Delta:     delta,
+		Balance:   item.Quantity,
+		Reason:    reason,
+		OrderID:   orderID,
+		Actor:     actor,
+		Note:      note,
+		CreatedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+
+	// 부족 기준을 넘어 내려간 경우에만 한 번 알림
+	if delta < 0 && item.IsLow() && item.Quantity-delta > item.LowStockThreshold {
+		if err := s.events.Record(ctx, event.AggregateInventory, sku, event.InventoryLowStock, &LowStockEvent{
+			SKU:        sku,
+			Name:       item.Name,
+			Quantity:   item.Quantity,
+			Threshold:  item.LowStockThreshold,
+			OrderID:    orderID,
+			OccurredAt: now,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return item, nil
+}
+
+// outOfStock 재고 부족 에러 (부족한 품목과 남은 수량 포함)
+func (s *Service) outOfStock(ctx context.Context, sku string, requested int) error {
+	item, err := s.repo.FindItem(ctx, sku)
+	if err != nil {
+		return err
+	}
+
+	details := map[string]interface{}{
+		"sku":       sku,
+		"requested": requested,
+		"available": 0,
+	}
+
+	if item != nil {
+		details["name"] = item.Name
+		details["available"] = item.Quantity
+	}
+
+	return errors.NewError(
+		errors.StatusConflict,
+		"OUT_OF_STOCK",
+		"재고가 부족해서 주문할 수 없습니다.",
+		details,
+	)
+}
+
+func (s *Service) findItem(ctx context.Context, sku string) (*StockItem, error) {
+	if !skuPattern.MatchString(sku) {
+		return nil, ErrStockItemNotFound
+	}
+
+	item, err := s.repo.FindItem(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		return nil, ErrStockItemNotFound
+	}
+
+	return item, nil
+}
+
+func newStockItemResponse(item *StockItem) StockItemResponse {
+	return StockItemResponse{
+		StockItem: *item,
+		LowStock:  item.IsLow(),
+	}
+}
@@ -0,0 +1,39 @@
+package menu
+
+// CreateItemRequest 메뉴 항목 생성 요청 DTO
+type CreateItemRequest struct {
+	ItemID       string `json:"itemId" binding:"required"`
+	Name         string `json:"name" binding:"required,max=100"`
+	Description  string `json:"description,omitempty" binding:"max=255"`
+	Category     string `json:"category" binding:"required,oneof=RAMEN TOPPING DRINK OPTION"`
+	Price        *int   `json:"price" binding:"required,min=0"`
+	Available    *bool  `json:"available,omitempty"`
+	DisplayOrder int    `json:"displayOrder"`
+}
+
+// UpdateItemRequest 메뉴 항목 수정 요청 DTO (전달한 항목만 변경)
+type UpdateItemRequest struct {
+	Name         *string `json:"name,omitempty" binding:"omitempty,min=1,max=100"`
+	Description  *string `json:"description,omitempty" binding:"omitempty,max=255"`
+	Category     *string `json:"category,omitempty" binding:"omitempty,oneof=RAMEN TOPPING DRINK OPTION"`
+	Price        *int    `json:"price,omitempty" binding:"omitempty,min=0"`
+	Available    *bool   `json:"available,omitempty"`
+	DisplayOrder *int    `json:"displayOrder,omitempty"`
+}
+
+// ListItemsRequest 메뉴 조회 요청 DTO
+type ListItemsRequest struct {
+	Category string `form:"category" binding:"omitempty,oneof=RAMEN TOPPING DRINK OPTION"`
+}
+
+// ItemListResponse 메뉴 목록 응답 DTO
+type ItemListResponse struct {
+	Items []Item `json:"items"`
+}
+
+// ErrorResponse 에러 응답 DTO
+type ErrorResponse struct {
+	Error   string      `json:"error"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
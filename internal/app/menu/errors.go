@@ -0,0 +1,13 @@
+package menu
+
+import (
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 메뉴 관련 에러
+var (
+	ErrItemNotFound    = errors.NotFound("NOT_FOUND", "해당 메뉴를 찾을 수 없습니다.")
+	ErrItemExists      = errors.Conflict("MENU_ITEM_EXISTS", "이미 같은 ID의 메뉴가 있습니다.")
+	ErrInvalidItemID   = errors.BadRequest("INVALID_REQUEST", "메뉴 ID는 영문 소문자, 숫자, 밑줄(_)로 된 2~50자여야 합니다.")
+	ErrItemUnavailable = errors.BadRequest("MENU_ITEM_UNAVAILABLE", "주문할 수 없는 메뉴입니다.")
+)
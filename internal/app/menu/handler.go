@@ -0,0 +1,136 @@
+package menu
+
+import (
+	"net/http"
+
+	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 메뉴 핸들러
+type Handler struct {
+	service *Service
+}
+
+// NewHandler 메뉴 핸들러 생성
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes 라우트 등록
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	r.GET("/menu", h.GetMenu)
+
+	admin := r.Group("/admin")
+	{
+		admin.Use(middleware.AdminAuth())
+		admin.GET("/menu", h.GetAllItems)
+		admin.POST("/menu", h.CreateItem)
+		admin.GET("/menu/:itemId", h.GetItem)
+		admin.PATCH("/menu/:itemId", h.UpdateItem)
+		admin.DELETE("/menu/:itemId", h.DeleteItem)
+	}
+}
+
+// GetMenu 판매 중인 메뉴 조회 핸들러
+func (h *Handler) GetMenu(c *gin.Context) {
+	var req ListItemsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "메뉴 조회 조건이 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.GetMenu(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetAllItems 전체 메뉴 조회 핸들러 (관리자용)
+func (h *Handler) GetAllItems(c *gin.Context) {
+	var req ListItemsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "메뉴 조회 조건이 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.GetAllItems(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetItem 메뉴 항목 조회 핸들러
+func (h *Handler) GetItem(c *gin.Context) {
+	result, err := h.service.GetItem(c, c.Param("itemId"))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CreateItem 메뉴 항목 생성 핸들러
+func (h *Handler) CreateItem(c *gin.Context) {
+	var req CreateItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "메뉴 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.CreateItem(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// UpdateItem 메뉴 항목 수정 핸들러
+func (h *Handler) UpdateItem(c *gin.Context) {
+	var req UpdateItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "메뉴 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.UpdateItem(c, c.Param("itemId"), req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteItem 메뉴 항목 삭제 핸들러
+func (h *Handler) DeleteItem(c *gin.Context) {
+	if err := h.service.DeleteItem(c, c.Param("itemId")); err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
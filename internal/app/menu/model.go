@@ -0,0 +1,33 @@
+package menu
+
+import (
+	"time"
+)
+
+// Item 메뉴 항목
+type Item struct {
+	ID           string    `json:"itemId"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description,omitempty"`
+	Category     string    `json:"category"`
+	Price        int       `json:"price"`
+	Available    bool      `json:"available"`
+	SoldOut      bool      `json:"soldOut"` // 재고 부족 여부 (조회 시 재고로 계산)
+	DisplayOrder int       `json:"displayOrder"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// Category 상수 정의
+const (
+	CategoryRamen   = "RAMEN"
+	CategoryTopping = "TOPPING"
+	CategoryDrink   = "DRINK"
+	CategoryOption  = "OPTION"
+)
+
+// 주문 옵션에 해당하는 메뉴 항목 ID (옵션 가격을 메뉴에서 관리)
+const (
+	OptionHotWaterDelivery = "hot_water_delivery"
+	OptionCookingService   = "cooking_service"
+)
@@ -0,0 +1,29 @@
+package menu
+
+import (
+	"context"
+)
+
+// Filter 메뉴 조회 조건
+type Filter struct {
+	Category      string
+	AvailableOnly bool
+}
+
+// Repository 메뉴 리포지토리 인터페이스
+type Repository interface {
+	// Create 새로운 메뉴 항목 생성 (ID가 중복되면 ErrItemExists)
+	Create(ctx context.Context, item *Item) error
+
+	// FindByID 메뉴 항목 조회 (없으면 nil)
+	FindByID(ctx context.Context, itemID string) (*Item, error)
+
+	// FindAll 조건에 맞는 메뉴 항목을 표시 순서대로 조회
+	FindAll(ctx context.Context, filter Filter) ([]Item, error)
+
+	// Update 메뉴 항목 업데이트
+	Update(ctx context.Context, item *Item) error
+
+	// Delete 메뉴 항목 삭제 (이미 접수된 주문은 주문 시점의 가격을 따로 보관)
+	Delete(ctx context.Context, itemID string) error
+}
@@ -0,0 +1,165 @@
+package menu
+
+import (
+	"context"
+	"regexp"
+	"time"
+)
+
+// itemIDPattern 메뉴 항목 ID 형식 (예: shin_ramyun)
+var itemIDPattern = regexp.MustCompile(`^[a-z0-9_]{2,50}$`)
+
+// StockChecker 재고가 부족한 메뉴를 확인하는 인터페이스
+type StockChecker interface {
+	SoldOutMenuItems(ctx context.Context, menuItemIDs []string) (map[string]bool, error)
+}
+
+// Service 메뉴 서비스
+type Service struct {
+	repo  Repository
+	stock StockChecker
+}
+
+// NewService 메뉴 서비스 생성
+func NewService(repo Repository, stock StockChecker) *Service {
+	return &Service{repo: repo, stock: stock}
+}
+
+// GetMenu 판매 중인 메뉴 조회 (고객용)
+func (s *Service) GetMenu(ctx context.Context, req ListItemsRequest) (*ItemListResponse, error) {
+	return s.list(ctx, Filter{Category: req.Category, AvailableOnly: true})
+}
+
+// GetAllItems 판매 중지된 항목을 포함한 전체 메뉴 조회 (관리자용)
+func (s *Service) GetAllItems(ctx context.Context, req ListItemsRequest) (*ItemListResponse, error) {
+	return s.list(ctx, Filter{Category: req.Category})
+}
+
+// GetItem 메뉴 항목 조회 (관리자용)
+func (s *Service) GetItem(ctx context.Context, itemID string) (*Item, error) {
+	return s.findItem(ctx, itemID)
+}
+
+// CreateItem 메뉴 항목 생성
+func (s *Service) CreateItem(ctx context.Context, req CreateItemRequest) (*Item, error) {
+	if !itemIDPattern.MatchString(req.ItemID) {
+		return nil, ErrInvalidItemID
+	}
+
+	available := true
+	if req.Available != nil {
+		available = *req.Available
+	}
+
+	item := &Item{
+		ID:           req.ItemID,
+		Name:         req.Name,
+		Description:  req.Description,
+		Category:     req.Category,
+		Price:        *req.Price,
+		Available:    available,
+		DisplayOrder: req.DisplayOrder,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	if err := s.repo.Create(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// UpdateItem 메뉴 항목 수정 (가격을 바꿔도 이미 접수된 주문의 가격은 바뀌지 않음)
+func (s *Service) UpdateItem(ctx context.Context, itemID string, req UpdateItemRequest) (*Item, error) {
+	item, err := s.findItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		item.Name = *req.Name
+	}
+
+	if req.Description != nil {
+		item.Description = *req.Description
+	}
+
+	if req.Category != nil {
+		item.Category = *req.Category
+	}
+
+	if req.Price != nil {
+		item.Price = *req.Price
+	}
+
+	if req.Available != nil {
+		item.Available = *req.Available
+	}
+
+	if req.DisplayOrder != nil {
+		item.DisplayOrder = *req.DisplayOrder
+	}
+
+	item.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// DeleteItem 메뉴 항목 삭제
+func (s *Service) DeleteItem(ctx context.Context, itemID string) error {
+	if _, err := s.findItem(ctx, itemID); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, itemID)
+}
+
+func (s *Service) list(ctx context.Context, filter Filter) (*ItemListResponse, error) {
+	items, err := s.repo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if items == nil {
+		items = []Item{}
+	}
+
+	// 재고가 부족한 메뉴 표시
+	itemIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ID)
+	}
+
+	soldOut, err := s.stock.SoldOutMenuItems(ctx, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		items[i].SoldOut = soldOut[items[i].ID]
+	}
+
+	return &ItemListResponse{Items: items}, nil
+}
+
+func (s *Service) findItem(ctx context.Context, itemID string) (*Item, error) {
+	if !itemIDPattern.MatchString(itemID) {
+		return nil, ErrItemNotFound
+	}
+
+	item, err := s.repo.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		return nil, ErrItemNotFound
+	}
+
+	return item, nil
+}
@@ -1,39 +1,209 @@
 package order
 
+import (
+	"time"
+
+	"github.com/myramen/be/internal/app/pricing"
+)
+
 // CreateOrderRequest 주문 생성 요청 DTO
 type CreateOrderRequest struct {
-	Name           string  `json:"name" binding:"required"`
-	AccountNumber  string  `json:"accountNumber" binding:"required"`
-	Quantity       int     `json:"quantity" binding:"required,min=1"`
-	SpicyLevel     int     `json:"spicyLevel" binding:"min=1,max=5"`
-	DeliveryOption string  `json:"deliveryOption" binding:"required"`
-	Options        Options `json:"options"`
-	CouponID       string  `json:"couponId,omitempty"`
+	Name          string `json:"name" binding:"required"`
+	AccountNumber string `json:"accountNumber" binding:"required"`
+	OrderDraftRequest
+
+	// 로그인한 고객 ID (요청 본문이 아닌 세션에서 채움, 비로그인 주문은 0)
+	CustomerID int64 `json:"-"`
+}
+
+// OrderDraftRequest 주문 내용 DTO (주문 생성과 견적 요청이 함께 사용)
+type OrderDraftRequest struct {
+	Items          []OrderItemRequest `json:"items,omitempty" binding:"omitempty,max=20,dive"`
+	DeliveryOption string             `json:"deliveryOption" binding:"required,oneof=PICKUP_4F PICKUP_LAUNDRY DELIVERY"`
+	Options        Options            `json:"options"`
+	CouponID       string             `json:"couponId,omitempty"`
+
+	// 항목 하나짜리 기존 요청 형식 (items 대신 사용)
+	Quantity   int    `json:"quantity,omitempty" binding:"omitempty,min=1,max=100"`
+	MenuItemID string `json:"menuItemId,omitempty"`
+	SpicyLevel int    `json:"spicyLevel,omitempty" binding:"omitempty,min=1,max=5"`
+}
+
+// QuoteOrderRequest 주문 견적 요청 DTO
+// 이름과 계좌번호는 선택이며, 둘 다 있으면 비로그인 주문으로 받은 쿠폰의 소유자 확인에 사용한다.
+type QuoteOrderRequest struct {
+	Name          string `json:"name,omitempty"`
+	AccountNumber string `json:"accountNumber,omitempty"`
+	OrderDraftRequest
+
+	// 로그인한 고객 ID (요청 본문이 아닌 세션에서 채움, 비로그인 견적은 0)
+	CustomerID int64 `json:"-"`
+}
+
+// OrderItemRequest 주문 항목 요청 DTO
+type OrderItemRequest struct {
+	MenuItemID string   `json:"menuItemId,omitempty"`
+	Quantity   int      `json:"quantity" binding:"required,min=1,max=100"`
+	SpicyLevel int      `json:"spicyLevel,omitempty" binding:"omitempty,min=1,max=5"`
+	Toppings   []string `json:"toppings,omitempty" binding:"omitempty,max=10,unique,dive,required"`
+}
+
+// lineItems 요청의 주문 항목 목록 (기존 형식은 항목 하나로 변환)
+func (r OrderDraftRequest) lineItems() ([]OrderItemRequest, error) {
+	legacy := r.Quantity != 0 || r.MenuItemID != "" || r.SpicyLevel != 0
+
+	if len(r.Items) > 0 {
+		if legacy {
+			return nil, ErrMixedOrderItems
+		}
+		return r.Items, nil
+	}
+
+	if r.Quantity == 0 {
+		return nil, ErrOrderItemsRequired
+	}
+
+	return []OrderItemRequest{{
+		MenuItemID: r.MenuItemID,
+		Quantity:   r.Quantity,
+		SpicyLevel: r.SpicyLevel,
+	}}, nil
 }
 
 // OrderResponse 주문 응답 DTO
 type OrderResponse struct {
-	OrderID        string  `json:"orderId"`
-	Name           string  `json:"name"`
-	AccountNumber  string  `json:"accountNumber"`
-	Quantity       int     `json:"quantity"`
-	SpicyLevel     int     `json:"spicyLevel"`
-	DeliveryOption string  `json:"deliveryOption"`
-	Options        Options `json:"options"`
-	TotalPrice     int     `json:"totalPrice"`
-	Status         string  `json:"status"`
-	AppliedCoupon  *Coupon `json:"appliedCoupon,omitempty"`
-	NewCoupon      *Coupon `json:"newCoupon,omitempty"`
+	OrderID        string               `json:"orderId"`
+	CustomerID     int64                `json:"customerId,omitempty"`
+	Name           string               `json:"name"`
+	AccountNumber  string               `json:"accountNumber"`
+	Quantity       int                  `json:"quantity"`
+	Items          []OrderItem          `json:"items"`
+	DeliveryOption string               `json:"deliveryOption"`
+	Options        Options              `json:"options"`
+	OptionsPrice   int                  `json:"optionsPrice"`
+	TotalPrice     int                  `json:"totalPrice"`
+	PriceBreakdown *pricing.Breakdown   `json:"priceBreakdown,omitempty"`
+	Status         string               `json:"status"`
+	AppliedCoupon  *Coupon              `json:"appliedCoupon,omitempty"`
+	NewCoupon      *Coupon              `json:"newCoupon,omitempty"`
+	CancelReason   string               `json:"cancelReason,omitempty"`
+	CancelledAt    *time.Time           `json:"cancelledAt,omitempty"`
+	Refund         *Refund              `json:"refund,omitempty"`
+	LookupToken    string               `json:"lookupToken,omitempty"`
+	Timeline       []StatusHistoryEntry `json:"timeline,omitempty"`
+}
+
+// QuoteResponse 주문 견적 응답 DTO (저장하지 않은 주문의 예상 가격)
+type QuoteResponse struct {
+	Quantity       int                `json:"quantity"`
+	Items          []OrderItem        `json:"items"`
+	DeliveryOption string             `json:"deliveryOption"`
+	Options        Options            `json:"options"`
+	OptionsPrice   int                `json:"optionsPrice"`
+	TotalPrice     int                `json:"totalPrice"`
+	PriceBreakdown *pricing.Breakdown `json:"priceBreakdown"`
+	Coupon         *CouponQuote       `json:"coupon,omitempty"`
+	RewardCoupon   *Coupon            `json:"rewardCoupon,omitempty"`
+}
+
+// CouponQuote 견적에 적용한 쿠폰 정보 (적용할 수 없으면 이유 포함)
+type CouponQuote struct {
+	CouponID   string      `json:"couponId"`
+	Applicable bool        `json:"applicable"`
+	Discount   int         `json:"discount"`
+	Reason     string      `json:"reason,omitempty"`
+	Message    string      `json:"message,omitempty"`
+	Details    interface{} `json:"details,omitempty"`
+}
+
+// StatusHistoryEntry 주문 상태 변경 이력 항목 DTO (고객용 응답에서는 actor, note 생략)
+type StatusHistoryEntry struct {
+	PreviousStatus string    `json:"previousStatus,omitempty"`
+	Status         string    `json:"status"`
+	Actor          string    `json:"actor,omitempty"`
+	Note           string    `json:"note,omitempty"`
+	ChangedAt      time.Time `json:"changedAt"`
+}
+
+// StatusHistoryResponse 주문 상태 변경 이력 응답 DTO
+type StatusHistoryResponse struct {
+	OrderID string               `json:"orderId"`
+	Status  string               `json:"status"`
+	History []StatusHistoryEntry `json:"history"`
+}
+
+// PublicOrderResponse 조회 토큰 없이 공개되는 주문 정보 DTO (개인정보 제외)
+type PublicOrderResponse struct {
+	OrderID        string    `json:"orderId"`
+	Quantity       int       `json:"quantity"`
+	DeliveryOption string    `json:"deliveryOption"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// ListOrdersRequest 주문 목록 조회 쿼리 DTO
+type ListOrdersRequest struct {
+	Status         string `form:"status"` // 쉼표로 구분해 여러 상태 지정 가능
+	DeliveryOption string `form:"deliveryOption" binding:"omitempty,oneof=PICKUP_4F PICKUP_LAUNDRY DELIVERY"`
+	From           string `form:"from"` // RFC3339 또는 YYYY-MM-DD (포함)
+	To             string `form:"to"`   // RFC3339(제외) 또는 YYYY-MM-DD(해당 날짜 포함)
+	Name           string `form:"name"` // 주문자 이름 앞부분 일치
+	CouponUsed     *bool  `form:"couponUsed"`
+	MinTotal       *int   `form:"minTotal" binding:"omitempty,min=0"`
+	Sort           string `form:"sort" binding:"omitempty,oneof=-createdAt createdAt -totalPrice totalPrice"`
+	Limit          int    `form:"limit" binding:"omitempty,min=1,max=200"`
+	Cursor         string `form:"cursor"`
+}
+
+// CustomerOrdersRequest 로그인한 고객의 주문 목록 조회 요청 DTO
+type CustomerOrdersRequest struct {
+	Status string `form:"status"` // 쉼표로 구분해 여러 상태 지정 가능
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=200"`
+	Cursor string `form:"cursor"`
+}
+
+// ClaimOrdersRequest 비로그인 주문을 계정에 연결하는 요청 DTO
+type ClaimOrdersRequest struct {
+	Name          string `json:"name" binding:"required"`
+	AccountNumber string `json:"accountNumber" binding:"required"`
+}
+
+// ClaimOrdersResponse 계정에 연결한 주문 응답 DTO
+type ClaimOrdersResponse struct {
+	ClaimedCount int             `json:"claimedCount"`
+	Orders       []OrderResponse `json:"orders"`
 }
 
 // OrderListResponse 주문 목록 응답 DTO
 type OrderListResponse struct {
-	Orders []OrderResponse `json:"orders"`
+	Orders     []OrderResponse `json:"orders"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+	TotalCount int             `json:"totalCount"`
 }
 
 // UpdateOrderStatusRequest 주문 상태 변경 요청 DTO
 type UpdateOrderStatusRequest struct {
-	Status string `json:"status" binding:"required,oneof=PENDING PAID COOKING READY DELIVERING DELIVERED"`
+	Status string `json:"status" binding:"required,oneof=PENDING PAID COOKING READY DELIVERING DELIVERED CANCELLED"`
+	Note   string `json:"note" binding:"max=255"`
+}
+
+// CancelOrderRequest 주문 취소 요청 DTO
+type CancelOrderRequest struct {
+	Reason string `json:"reason" binding:"max=255"`
+}
+
+// RefundOrderRequest 환불 요청 DTO (amount 생략 시 결제 금액 전액 환불)
+type RefundOrderRequest struct {
+	Amount *int   `json:"amount" binding:"omitempty,min=0"`
+	Note   string `json:"note" binding:"max=255"`
+}
+
+// TransitionsResponse 주문 상태 전이 가능 목록 응답 DTO
+type TransitionsResponse struct {
+	OrderID            string   `json:"orderId"`
+	Status             string   `json:"status"`
+	DeliveryOption     string   `json:"deliveryOption"`
+	AllowedTransitions []string `json:"allowedTransitions"`
 }
 
 // ErrorResponse 에러 응답 DTO
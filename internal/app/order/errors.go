@@ -0,0 +1,29 @@
+package order
+
+import (
+	"github.com/myramen/be/internal/app/coupon"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 주문 관련 에러
+var (
+	ErrOrderNotFound       = errors.NotFound("NOT_FOUND", "해당 주문을 찾을 수 없습니다.")
+	ErrInvalidLookupToken  = errors.Forbidden("INVALID_LOOKUP_TOKEN", "주문 조회 토큰이 올바르지 않습니다.")
+	ErrLookupTokenRequired = errors.Unauthorized("LOOKUP_TOKEN_REQUIRED", "주문 조회 토큰이 필요합니다.")
+	ErrOrderItemsRequired  = errors.BadRequest("INVALID_REQUEST", "주문 항목(items) 또는 수량(quantity)이 필요합니다.")
+	ErrMixedOrderItems     = errors.BadRequest("INVALID_REQUEST", "items와 quantity, menuItemId, spicyLevel을 함께 보낼 수 없습니다.")
+	ErrDrinkCustomization  = errors.BadRequest("INVALID_REQUEST", "음료는 매운맛과 토핑을 선택할 수 없습니다.")
+)
+
+// ErrRewardCouponRedeemed 주문으로 발급한 구매 보상 쿠폰을 이미 사용해서 고객이 주문을 취소할 수 없음
+func ErrRewardCouponRedeemed(reward *coupon.Coupon) error {
+	return errors.NewError(
+		errors.StatusConflict,
+		"REWARD_COUPON_REDEEMED",
+		"이 주문으로 발급된 쿠폰을 이미 사용해서 취소할 수 없습니다. 관리자에게 문의해주세요.",
+		map[string]interface{}{
+			"couponId":      reward.CouponID,
+			"usedByOrderId": reward.UsedByOrderID,
+		},
+	)
+}
@@ -0,0 +1,78 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/myramen/be/internal/app/coupon"
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/pubsub"
+)
+
+// OrderEvent 주문 생성/상태 변경 이벤트 (주문 정보의 계좌번호는 마스킹)
+type OrderEvent struct {
+	OrderID        string         `json:"orderId"`
+	PreviousStatus string         `json:"previousStatus,omitempty"`
+	Status         string         `json:"status"`
+	Actor          string         `json:"actor"`
+	Note           string         `json:"note,omitempty"`
+	ChangedAt      time.Time      `json:"changedAt"`
+	Order          *OrderResponse `json:"order,omitempty"`
+}
+
+// CustomerOrderEvent 고객 스트림으로 보내는 이벤트 (처리 주체, 메모, 개인정보 제외)
+type CustomerOrderEvent struct {
+	OrderID        string    `json:"orderId"`
+	PreviousStatus string    `json:"previousStatus,omitempty"`
+	Status         string    `json:"status"`
+	ChangedAt      time.Time `json:"changedAt"`
+}
+
+// recordOrderEvent 주문 생성/상태 변경 이벤트를 트랜잭션 안에서 기록
+// 트랜잭션 안에서는 변경 후 주문을 다시 읽지 않으므로 변경 내용을 반영한 사본으로 만든다.
+func (s *Service) recordOrderEvent(ctx context.Context, eventType string, order *Order, change *StatusChange) error {
+	changed := *order
+	changed.Status = change.NewStatus
+	changed.UpdatedAt = change.CreatedAt
+
+	return s.events.Record(ctx, event.AggregateOrder, order.OrderID, eventType, &OrderEvent{
+		OrderID:        order.OrderID,
+		PreviousStatus: change.PreviousStatus,
+		Status:         change.NewStatus,
+		Actor:          change.Actor,
+		Note:           change.Note,
+		ChangedAt:      change.CreatedAt,
+		Order:          newCustomerOrderResponse(&changed),
+	})
+}
+
+// recordCouponEvent 쿠폰 발급/사용 이벤트를 트랜잭션 안에서 기록
+func (s *Service) recordCouponEvent(ctx context.Context, eventType string, payload *coupon.CouponEvent) error {
+	return s.events.Record(ctx, event.AggregateCoupon, payload.CouponID, eventType, payload)
+}
+
+// decodeOrderEvent 내부 버스로 받은 이벤트에서 주문 이벤트 추출
+func decodeOrderEvent(message pubsub.Event) (*OrderEvent, bool) {
+	e, ok := message.Data.(event.Event)
+	if !ok || e.AggregateType != event.AggregateOrder {
+		return nil, false
+	}
+
+	var orderEvent OrderEvent
+	if err := json.Unmarshal(e.Payload, &orderEvent); err != nil {
+		return nil, false
+	}
+
+	return &orderEvent, true
+}
+
+// newCustomerOrderEvent 주문 이벤트에서 고객에게 보낼 정보만 추림
+func newCustomerOrderEvent(e *OrderEvent) *CustomerOrderEvent {
+	return &CustomerOrderEvent{
+		OrderID:        e.OrderID,
+		PreviousStatus: e.PreviousStatus,
+		Status:         e.Status,
+		ChangedAt:      e.ChangedAt,
+	}
+}
@@ -0,0 +1,281 @@
+package order
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/myramen/be/internal/app/campaign"
+	"github.com/myramen/be/internal/app/coupon"
+	"github.com/myramen/be/internal/app/inventory"
+	"github.com/myramen/be/internal/app/menu"
+	"github.com/myramen/be/internal/app/pricing"
+	"github.com/myramen/be/internal/pkg/encryption"
+	"github.com/myramen/be/internal/pkg/idgen"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 주문 서비스 테스트용 메모리 저장소
+// 인터페이스를 임베드해서 테스트에 필요한 메서드만 구현하며, 그 외 메서드를 호출하면 패닉이 난다.
+
+// fakeTx 트랜잭션 없이 fn을 그대로 실행
+type fakeTx struct{}
+
+func (fakeTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// nopRecorder 이벤트를 기록하지 않는 Recorder
+type nopRecorder struct{}
+
+func (nopRecorder) Record(ctx context.Context, aggregateType, aggregateID, eventType string, payload interface{}) error {
+	return nil
+}
+
+type fakeOrderRepo struct {
+	Repository
+
+	mu      sync.Mutex
+	orders  map[string]*Order
+	history map[string][]StatusChange
+}
+
+func (r *fakeOrderRepo) Create(ctx context.Context, order *Order) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *order
+	r.orders[order.OrderID] = &saved
+	return nil
+}
+
+func (r *fakeOrderRepo) FindByID(ctx context.Context, orderID string) (*Order, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order, ok := r.orders[orderID]
+	if !ok {
+		return nil, nil
+	}
+
+	found := *order
+	return &found, nil
+}
+
+// UpdateStatus MySQL처럼 현재 상태가 fromStatus일 때만 변경
+func (r *fakeOrderRepo) UpdateStatus(ctx context.Context, orderID string, fromStatus string, status string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order, ok := r.orders[orderID]
+	if !ok || order.Status != fromStatus {
+		return errors.Conflict("ORDER_STATE_CHANGED", "주문 상태가 변경되었습니다. 다시 시도해주세요.")
+	}
+
+	order.Status = status
+	return nil
+}
+
+func (r *fakeOrderRepo) AddStatusHistory(ctx context.Context, orderID string, change *StatusChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.history[orderID] = append(r.history[orderID], *change)
+	return nil
+}
+
+// setStatus 주문 상태를 이력 없이 변경 (테스트 준비용)
+func (r *fakeOrderRepo) setStatus(orderID string, status string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.orders[orderID].Status = status
+}
+
+// fakeCouponRepo Redeem은 MySQL의 조건부 UPDATE처럼 미사용 쿠폰만 원자적으로 사용 처리
+type fakeCouponRepo struct {
+	coupon.Repository
+
+	mu      sync.Mutex
+	coupons map[string]*coupon.Coupon
+}
+
+func (r *fakeCouponRepo) Create(ctx context.Context, c *coupon.Coupon) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *c
+	r.coupons[c.CouponID] = &saved
+	return nil
+}
+
+func (r *fakeCouponRepo) FindByID(ctx context.Context, couponID string) (*coupon.Coupon, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.coupons[couponID]
+	if !ok {
+		return nil, nil
+	}
+
+	found := *c
+	return &found, nil
+}
+
+func (r *fakeCouponRepo) Redeem(ctx context.Context, couponID string, orderID string, redeemedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.coupons[couponID]
+	switch {
+	case !ok:
+		return coupon.ErrCouponNotFound
+	case c.IsUsed:
+		return coupon.ErrCouponAlreadyRedeemed
+	case c.RevokedAt != nil:
+		return coupon.ErrCouponRevoked
+	case !redeemedAt.Before(c.ExpiryDate):
+		return coupon.ErrCouponExpired
+	}
+
+	c.IsUsed = true
+	c.UsedByOrderID = orderID
+	c.UsedAt = &redeemedAt
+	return nil
+}
+
+type fakeMenuRepo struct {
+	menu.Repository
+
+	items []menu.Item
+}
+
+func (r *fakeMenuRepo) FindByID(ctx context.Context, itemID string) (*menu.Item, error) {
+	for i := range r.items {
+		if r.items[i].ID == itemID {
+			item := r.items[i]
+			return &item, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeMenuRepo) FindAll(ctx context.Context, filter menu.Filter) ([]menu.Item, error) {
+	var items []menu.Item
+	for _, item := range r.items {
+		if filter.Category != "" && item.Category != filter.Category {
+			continue
+		}
+		if filter.AvailableOnly && !item.Available {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// fakeInventoryRepo 재고를 쓰는 메뉴가 없는 재고 저장소
+type fakeInventoryRepo struct {
+	inventory.Repository
+}
+
+func (fakeInventoryRepo) FindUsages(ctx context.Context, menuItemIDs []string) ([]inventory.Usage, error) {
+	return nil, nil
+}
+
+type fakePricingRepo struct {
+	pricing.Repository
+
+	rules []pricing.Rule
+}
+
+func (r *fakePricingRepo) FindAll(ctx context.Context, activeOnly bool) ([]pricing.Rule, error) {
+	return r.rules, nil
+}
+
+type fakeCampaignRepo struct {
+	campaign.Repository
+
+	campaigns []campaign.Campaign
+}
+
+func (r *fakeCampaignRepo) FindAll(ctx context.Context) ([]campaign.Campaign, error) {
+	return r.campaigns, nil
+}
+
+// testService 테스트용 메모리 저장소로 만든 주문 서비스
+type testService struct {
+	*Service
+	orders  *fakeOrderRepo
+	coupons *fakeCouponRepo
+	menu    *fakeMenuRepo
+	rules   *fakePricingRepo
+}
+
+// newTestService 신라면(4000원), 계란 토핑(500원), 콜라(1500원)를 파는 주문 서비스 생성
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+
+	orders := &fakeOrderRepo{orders: make(map[string]*Order), history: make(map[string][]StatusChange)}
+	coupons := &fakeCouponRepo{coupons: make(map[string]*coupon.Coupon)}
+	menuRepo := &fakeMenuRepo{items: []menu.Item{
+		{ID: "shin_ramyun", Name: "신라면", Category: menu.CategoryRamen, Price: 4000, Available: true},
+		{ID: "egg", Name: "계란", Category: menu.CategoryTopping, Price: 500, Available: true},
+		{ID: "cola", Name: "콜라", Category: menu.CategoryDrink, Price: 1500, Available: true},
+	}}
+	rules := &fakePricingRepo{}
+
+	service := NewService(
+		orders,
+		coupons,
+		menuRepo,
+		inventory.NewService(fakeInventoryRepo{}, fakeTx{}, nopRecorder{}),
+		pricing.NewService(rules),
+		campaign.NewService(&fakeCampaignRepo{}),
+		encryption.NewBlindIndex("test-claim-key"),
+		fakeTx{},
+		idgen.NewRandomGenerator(),
+		nopRecorder{},
+	)
+
+	return &testService{Service: service, orders: orders, coupons: coupons, menu: menuRepo, rules: rules}
+}
+
+// addPaidOrder 신라면 한 그릇을 주문하고 결제 완료 상태로 변경
+func (s *testService) addPaidOrder(t *testing.T) *OrderResponse {
+	t.Helper()
+
+	order, err := s.CreateOrder(context.Background(), CreateOrderRequest{
+		Name:          "홍길동",
+		AccountNumber: "123-456-789",
+		OrderDraftRequest: OrderDraftRequest{
+			Items:          []OrderItemRequest{{MenuItemID: "shin_ramyun", Quantity: 1}},
+			DeliveryOption: "PICKUP_4F",
+		},
+	})
+	if err != nil {
+		t.Fatalf("create order: %v", err)
+	}
+
+	s.orders.setStatus(order.OrderID, StatusPaid)
+	return order
+}
+
+// addCoupon 누구나 쓸 수 있는 200원 정액 쿠폰 추가
+func (s *testService) addCoupon(t *testing.T) *coupon.Coupon {
+	t.Helper()
+
+	c := &coupon.Coupon{
+		CouponID:   idgen.NewRandomGenerator().NewCouponID(),
+		Type:       coupon.TypeFixed,
+		Discount:   200,
+		ExpiryDate: time.Now().Add(time.Hour),
+		IssuedAt:   time.Now(),
+	}
+
+	if err := s.coupons.Create(context.Background(), c); err != nil {
+		t.Fatalf("create coupon: %v", err)
+	}
+	return c
+}
@@ -1,9 +1,12 @@
 package order
 
 import (
+	"io"
 	"net/http"
 
+	"github.com/myramen/be/internal/app/customer"
 	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/pubsub"
 	"github.com/myramen/be/internal/pkg/utils/errors"
 
 	"github.com/gin-gonic/gin"
@@ -11,27 +14,49 @@ import (
 
 // Handler 주문 핸들러
 type Handler struct {
-	service *Service
+	service          *Service
+	idempotencyStore middleware.IdempotencyStore
+	hub              *pubsub.Hub
+	customers        *customer.Service
+	kitchenOrigins   []string
 }
 
 // NewHandler 주문 핸들러 생성
-func NewHandler(service *Service) *Handler {
-	return &Handler{service: service}
+// kitchenOrigins는 주방 화면 WebSocket 연결을 허용할 브라우저 Origin 목록이다.
+func NewHandler(service *Service, idempotencyStore middleware.IdempotencyStore, hub *pubsub.Hub, customers *customer.Service, kitchenOrigins []string) *Handler {
+	return &Handler{service: service, idempotencyStore: idempotencyStore, hub: hub, customers: customers, kitchenOrigins: kitchenOrigins}
 }
 
 // RegisterRoutes 라우트 등록
 func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
 	orders := r.Group("/orders")
 	{
-		orders.POST("", h.CreateOrder)
+		orders.POST("", customer.OptionalAuth(h.customers), middleware.Idempotency(h.idempotencyStore), h.CreateOrder)
+		orders.POST("/quote", customer.OptionalAuth(h.customers), h.QuoteOrder)
 		orders.GET("/:orderId", h.GetOrderByID)
+		orders.GET("/:orderId/events", h.StreamOrderEvents)
+		orders.POST("/:orderId/cancel", h.CancelOrder)
+	}
+
+	me := r.Group("/me")
+	{
+		me.Use(customer.Auth(h.customers))
+		me.GET("/orders", h.GetMyOrders)
+		me.POST("/orders/claim", h.ClaimOrders)
 	}
 
 	admin := r.Group("/admin")
 	{
 		admin.Use(middleware.AdminAuth())
 		admin.GET("/orders", h.GetAllOrders)
+		admin.GET("/orders/events", h.StreamAllOrderEvents)
+		admin.GET("/kitchen/ws", h.KitchenSocket)
+		admin.GET("/orders/:orderId", h.AdminGetOrderByID)
 		admin.PUT("/orders/:orderId/status", h.UpdateOrderStatus)
+		admin.GET("/orders/:orderId/transitions", h.GetAllowedTransitions)
+		admin.GET("/orders/:orderId/history", h.GetStatusHistory)
+		admin.POST("/orders/:orderId/cancel", h.AdminCancelOrder)
+		admin.POST("/orders/:orderId/refund", h.RefundOrder)
 	}
 }
 
@@ -46,10 +71,7 @@ func (h *Handler) CreateOrder(c *gin.Context) {
 		return
 	}
 
-	// 기본값 설정
-	if req.SpicyLevel == 0 {
-		req.SpicyLevel = 3 // 기본 매운맛 레벨
-	}
+	req.CustomerID = customer.CurrentID(c)
 
 	result, err := h.service.CreateOrder(c, req)
 	if err != nil {
@@ -60,6 +82,68 @@ func (h *Handler) CreateOrder(c *gin.Context) {
 	c.JSON(http.StatusCreated, result)
 }
 
+// GetMyOrders 로그인한 고객의 주문 목록 조회 핸들러
+func (h *Handler) GetMyOrders(c *gin.Context) {
+	var req CustomerOrdersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "주문 목록 조회 조건이 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.GetCustomerOrders(c, customer.CurrentID(c), req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ClaimOrders 비로그인 주문을 계정에 연결하는 핸들러
+func (h *Handler) ClaimOrders(c *gin.Context) {
+	var req ClaimOrdersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "주문자 이름과 계좌번호가 필요합니다.",
+		})
+		return
+	}
+
+	result, err := h.service.ClaimOrders(c, customer.CurrentID(c), req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// QuoteOrder 주문 견적 핸들러 (저장하지 않음)
+func (h *Handler) QuoteOrder(c *gin.Context) {
+	var req QuoteOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "견적 요청 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	req.CustomerID = customer.CurrentID(c)
+
+	result, err := h.service.QuoteOrder(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // GetOrderByID 주문 조회 핸들러
 func (h *Handler) GetOrderByID(c *gin.Context) {
 	orderID := c.Param("orderId")
@@ -71,6 +155,39 @@ func (h *Handler) GetOrderByID(c *gin.Context) {
 		return
 	}
 
+	// 조회 토큰이 없으면 개인정보를 제외한 공개 정보만 반환
+	token := lookupToken(c)
+	if token == "" {
+		result, err := h.service.GetPublicOrder(c, orderID)
+		if err != nil {
+			errors.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
+	result, err := h.service.GetCustomerOrder(c, orderID, token)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// AdminGetOrderByID 관리자 주문 조회 핸들러 (전체 정보)
+func (h *Handler) AdminGetOrderByID(c *gin.Context) {
+	orderID := c.Param("orderId")
+	if orderID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "주문 ID가 필요합니다.",
+		})
+		return
+	}
+
 	result, err := h.service.GetOrderByID(c, orderID)
 	if err != nil {
 		errors.HandleError(c, err)
@@ -80,9 +197,18 @@ func (h *Handler) GetOrderByID(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
-// GetAllOrders 모든 주문 조회 핸들러
+// GetAllOrders 주문 목록 조회 핸들러 (필터, 정렬, 커서 페이지네이션)
 func (h *Handler) GetAllOrders(c *gin.Context) {
-	result, err := h.service.GetAllOrders(c)
+	var req ListOrdersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "주문 목록 조회 조건이 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.GetAllOrders(c, req)
 	if err != nil {
 		errors.HandleError(c, err)
 		return
@@ -111,7 +237,47 @@ func (h *Handler) UpdateOrderStatus(c *gin.Context) {
 		return
 	}
 
-	result, err := h.service.UpdateOrderStatus(c, orderID, req.Status)
+	result, err := h.service.UpdateOrderStatus(c, orderID, req.Status, AdminActor(middleware.AdminName(c)), req.Note)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetStatusHistory 주문 상태 변경 이력 조회 핸들러
+func (h *Handler) GetStatusHistory(c *gin.Context) {
+	orderID := c.Param("orderId")
+	if orderID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "주문 ID가 필요합니다.",
+		})
+		return
+	}
+
+	result, err := h.service.GetStatusHistory(c, orderID)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetAllowedTransitions 주문 상태 전이 가능 목록 조회 핸들러
+func (h *Handler) GetAllowedTransitions(c *gin.Context) {
+	orderID := c.Param("orderId")
+	if orderID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "주문 ID가 필요합니다.",
+		})
+		return
+	}
+
+	result, err := h.service.GetAllowedTransitions(c, orderID)
 	if err != nil {
 		errors.HandleError(c, err)
 		return
@@ -119,3 +285,95 @@ func (h *Handler) UpdateOrderStatus(c *gin.Context) {
 
 	c.JSON(http.StatusOK, result)
 }
+
+// CancelOrder 고객 주문 취소 핸들러 (결제 전 주문만 가능)
+func (h *Handler) CancelOrder(c *gin.Context) {
+	h.cancelOrder(c, false)
+}
+
+// AdminCancelOrder 관리자 주문 취소 핸들러
+func (h *Handler) AdminCancelOrder(c *gin.Context) {
+	h.cancelOrder(c, true)
+}
+
+func (h *Handler) cancelOrder(c *gin.Context, byAdmin bool) {
+	orderID := c.Param("orderId")
+	if orderID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "주문 ID가 필요합니다.",
+		})
+		return
+	}
+
+	// 요청 본문은 선택 사항
+	var req CancelOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "취소 요청 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	var (
+		result *OrderResponse
+		err    error
+	)
+	if byAdmin {
+		result, err = h.service.CancelOrder(c, orderID, req.Reason, AdminActor(middleware.AdminName(c)))
+	} else {
+		result, err = h.service.CancelOrderByCustomer(c, orderID, lookupToken(c), req.Reason)
+	}
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// RefundOrder 환불 기록 핸들러
+func (h *Handler) RefundOrder(c *gin.Context) {
+	orderID := c.Param("orderId")
+	if orderID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "주문 ID가 필요합니다.",
+		})
+		return
+	}
+
+	// 요청 본문은 선택 사항
+	var req RefundOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "환불 요청 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.RefundOrder(c, orderID, req.Amount, req.Note, AdminActor(middleware.AdminName(c)))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// lookupToken 요청에서 주문 조회 토큰 추출 (X-Order-Token 헤더)
+// 쿼리 파라미터는 접근 로그나 브라우저 기록에 남으므로 받지 않는다.
+func lookupToken(c *gin.Context) string {
+	return c.GetHeader("X-Order-Token")
+}
+
+// streamToken 구독 요청에서 주문 조회 토큰 추출 (X-Order-Token 헤더 또는 token 쿼리 파라미터)
+// 헤더를 보낼 수 없는 브라우저 EventSource를 위해 구독에서만 쿼리 파라미터를 허용한다. (접근 로그에는 가려서 기록)
+func streamToken(c *gin.Context) string {
+	if token := lookupToken(c); token != "" {
+		return token
+	}
+	return c.Query("token")
+}
@@ -0,0 +1,245 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/pubsub"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// 주방 화면 메시지 유형
+const (
+	KitchenMessageOrderCreated = "order.created"
+	KitchenMessageOrderUpdated = "order.updated"
+	KitchenMessageCommand      = "command"
+	KitchenMessageAck          = "ack"
+	KitchenMessageError        = "error"
+)
+
+// 주방 화면 명령
+const (
+	KitchenCommandStartCooking = "start_cooking"
+	KitchenCommandReady        = "ready"
+)
+
+// kitchenCommandStatus 주방 명령별 변경할 주문 상태
+var kitchenCommandStatus = map[string]string{
+	KitchenCommandStartCooking: StatusCooking,
+	KitchenCommandReady:        StatusReady,
+}
+
+// KitchenProtocol 주방 화면 WebSocket 서브프로토콜
+// 인증 정보를 서브프로토콜로 보내는 브라우저는 이 값도 함께 보내야 서버가 응답으로 선택할 수 있다.
+const KitchenProtocol = "myramen.kitchen"
+
+// KitchenPingInterval 연결 유지를 위한 ping 프레임 전송 주기
+const KitchenPingInterval = 30 * time.Second
+
+// KitchenCommand 주방 화면에서 보내는 명령 메시지
+type KitchenCommand struct {
+	Type      string `json:"type"`
+	RequestID string `json:"requestId"`
+	Command   string `json:"command"`
+	OrderID   string `json:"orderId"`
+	Note      string `json:"note,omitempty"`
+}
+
+// KitchenMessage 주방 화면으로 보내는 메시지
+type KitchenMessage struct {
+	Type      string         `json:"type"`
+	EventID   string         `json:"eventId,omitempty"`
+	RequestID string         `json:"requestId,omitempty"`
+	Event     *OrderEvent    `json:"event,omitempty"`
+	Order     *OrderResponse `json:"order,omitempty"`
+	Applied   *bool          `json:"applied,omitempty"`
+	Error     string         `json:"error,omitempty"`
+	Message   string         `json:"message,omitempty"`
+	Details   interface{}    `json:"details,omitempty"`
+}
+
+// pingCodec 빈 ping 프레임 전송용 코덱
+var pingCodec = websocket.Codec{
+	Marshal: func(v interface{}) ([]byte, byte, error) {
+		return nil, websocket.PingFrame, nil
+	},
+}
+
+// KitchenSocket 주방 화면 WebSocket 핸들러
+// 새 주문과 상태 변경을 받고, 조리 시작/준비 완료 명령을 보낼 수 있다.
+func (h *Handler) KitchenSocket(c *gin.Context) {
+	actor := AdminActor(middleware.AdminName(c))
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("lastEventId")
+	}
+
+	server := websocket.Server{
+		Handshake: func(config *websocket.Config, req *http.Request) error {
+			if err := h.checkKitchenOrigin(req.Header.Get("Origin")); err != nil {
+				return err
+			}
+
+			// 인증 정보가 담긴 서브프로토콜을 응답에 돌려보내지 않도록 KitchenProtocol만 선택
+			config.Protocol = selectKitchenProtocol(config.Protocol)
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			h.serveKitchen(conn, actor, lastEventID)
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// checkKitchenOrigin 브라우저 Origin이 허용 목록에 있는지 확인
+// 관리자 비밀번호를 저장한 브라우저에서 다른 사이트가 연결을 여는 것을 막는다. Origin을 보내지 않는 브라우저 외 클라이언트는 허용한다.
+func (h *Handler) checkKitchenOrigin(origin string) error {
+	if origin == "" {
+		return nil
+	}
+
+	for _, allowed := range h.kitchenOrigins {
+		if strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return nil
+		}
+	}
+	return fmt.Errorf("origin %q is not allowed", origin)
+}
+
+// selectKitchenProtocol 클라이언트가 KitchenProtocol을 보냈으면 그것만 선택
+func selectKitchenProtocol(offered []string) []string {
+	for _, protocol := range offered {
+		if protocol == KitchenProtocol {
+			return []string{KitchenProtocol}
+		}
+	}
+	return nil
+}
+
+func (h *Handler) serveKitchen(conn *websocket.Conn, actor string, lastEventID string) {
+	defer conn.Close()
+
+	sub, replay := h.hub.Subscribe(isOrderEvent, lastEventID)
+	defer sub.Close()
+
+	for _, message := range replay {
+		if err := sendKitchenEvent(conn, message); err != nil {
+			return
+		}
+	}
+
+	// 명령 수신은 별도 고루틴에서 처리 (전송은 Conn 내부에서 직렬화됨)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.receiveKitchenCommands(conn, actor)
+	}()
+
+	ping := time.NewTicker(KitchenPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case message, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			if err := sendKitchenEvent(conn, message); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := pingCodec.Send(conn, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// receiveKitchenCommands 연결이 끊길 때까지 명령을 읽어 처리하고 결과를 응답
+func (h *Handler) receiveKitchenCommands(conn *websocket.Conn, actor string) {
+	for {
+		var raw []byte
+		if err := websocket.Message.Receive(conn, &raw); err != nil {
+			return
+		}
+
+		reply := h.handleKitchenCommand(conn.Request().Context(), raw, actor)
+		if err := websocket.JSON.Send(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+// handleKitchenCommand 명령 하나를 적용하고 ack 또는 error 메시지 반환
+func (h *Handler) handleKitchenCommand(ctx context.Context, raw []byte, actor string) *KitchenMessage {
+	var cmd KitchenCommand
+	if err := json.Unmarshal(raw, &cmd); err != nil || cmd.Type != KitchenMessageCommand {
+		return newKitchenErrorMessage(cmd.RequestID, "INVALID_REQUEST", "명령 형식이 유효하지 않습니다.")
+	}
+
+	status, ok := kitchenCommandStatus[cmd.Command]
+	if !ok {
+		return newKitchenErrorMessage(cmd.RequestID, "INVALID_COMMAND", "지원하지 않는 명령입니다.")
+	}
+
+	if cmd.OrderID == "" {
+		return newKitchenErrorMessage(cmd.RequestID, "INVALID_REQUEST", "주문 ID가 필요합니다.")
+	}
+
+	result, applied, err := h.service.EnsureOrderStatus(ctx, cmd.OrderID, status, actor, cmd.Note)
+	if err != nil {
+		if customError, ok := err.(errors.CustomError); ok {
+			reply := newKitchenErrorMessage(cmd.RequestID, customError.Code, customError.Message)
+			reply.Details = customError.Details
+			return reply
+		}
+		return newKitchenErrorMessage(cmd.RequestID, "INTERNAL_ERROR", "Internal server error")
+	}
+
+	return &KitchenMessage{
+		Type:      KitchenMessageAck,
+		RequestID: cmd.RequestID,
+		Order:     result,
+		Applied:   &applied,
+	}
+}
+
+// sendKitchenEvent 내부 버스 이벤트를 주방 화면 메시지로 변환해 전송
+func sendKitchenEvent(conn *websocket.Conn, message pubsub.Event) error {
+	orderEvent, ok := decodeOrderEvent(message)
+	if !ok {
+		return nil
+	}
+
+	messageType := KitchenMessageOrderUpdated
+	if message.Type == event.OrderCreated {
+		messageType = KitchenMessageOrderCreated
+	}
+
+	return websocket.JSON.Send(conn, &KitchenMessage{
+		Type:    messageType,
+		EventID: message.ID,
+		Event:   orderEvent,
+	})
+}
+
+func newKitchenErrorMessage(requestID string, code string, message string) *KitchenMessage {
+	return &KitchenMessage{
+		Type:      KitchenMessageError,
+		RequestID: requestID,
+		Error:     code,
+		Message:   message,
+	}
+}
@@ -0,0 +1,146 @@
+package order
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/config"
+	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/pubsub"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	kitchenTestOrigin   = "http://kitchen.example"
+	kitchenTestPassword = "kitchen-secret"
+)
+
+// newKitchenServer 김철수 관리자 계정으로 인증하는 주문 API 테스트 서버 생성
+func newKitchenServer(t *testing.T, s *testService) *httptest.Server {
+	t.Helper()
+
+	previous := config.AppConfig
+	config.AppConfig.AdminAccounts = "김철수:" + kitchenTestPassword
+	t.Cleanup(func() { config.AppConfig = previous })
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewHandler(s.Service, nil, pubsub.NewHub(10), nil, []string{kitchenTestOrigin}).RegisterRoutes(router.Group(""))
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// dialKitchen 주방 화면 WebSocket 연결
+func dialKitchen(t *testing.T, server *httptest.Server, origin string, header http.Header, protocols ...string) (*websocket.Conn, error) {
+	t.Helper()
+
+	cfg, err := websocket.NewConfig("ws"+strings.TrimPrefix(server.URL, "http")+"/admin/kitchen/ws", origin)
+	if err != nil {
+		t.Fatalf("websocket config: %v", err)
+	}
+	cfg.Header = header
+	cfg.Protocol = protocols
+
+	conn, err := websocket.DialConfig(cfg)
+	if err == nil {
+		t.Cleanup(func() { conn.Close() })
+	}
+	return conn, err
+}
+
+// sendKitchenCommand 명령을 보내고 응답 메시지 하나를 받음
+func sendKitchenCommand(t *testing.T, conn *websocket.Conn, cmd KitchenCommand) KitchenMessage {
+	t.Helper()
+
+	if err := websocket.JSON.Send(conn, cmd); err != nil {
+		t.Fatalf("send command: %v", err)
+	}
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	var reply KitchenMessage
+	if err := websocket.JSON.Receive(conn, &reply); err != nil {
+		t.Fatalf("receive reply: %v", err)
+	}
+	return reply
+}
+
+func TestKitchenSocketAppliesCommandsOnce(t *testing.T) {
+	s := newTestService(t)
+	order := s.addPaidOrder(t)
+	server := newKitchenServer(t, s)
+
+	conn, err := dialKitchen(t, server, kitchenTestOrigin, http.Header{"X-Admin-Password": {kitchenTestPassword}})
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+
+	start := KitchenCommand{Type: KitchenMessageCommand, RequestID: "r-1", Command: KitchenCommandStartCooking, OrderID: order.OrderID}
+
+	reply := sendKitchenCommand(t, conn, start)
+	if reply.Type != KitchenMessageAck || reply.RequestID != "r-1" || reply.Applied == nil || !*reply.Applied {
+		t.Fatalf("first start_cooking reply = %+v, want applied ack for r-1", reply)
+	}
+	if reply.Order == nil || reply.Order.Status != StatusCooking {
+		t.Errorf("first start_cooking order = %+v, want status %s", reply.Order, StatusCooking)
+	}
+
+	start.RequestID = "r-2"
+	reply = sendKitchenCommand(t, conn, start)
+	if reply.Type != KitchenMessageAck || reply.RequestID != "r-2" || reply.Applied == nil || *reply.Applied {
+		t.Fatalf("repeated start_cooking reply = %+v, want ack for r-2 with applied=false", reply)
+	}
+
+	reply = sendKitchenCommand(t, conn, KitchenCommand{Type: KitchenMessageCommand, RequestID: "r-3", Command: "boil_water", OrderID: order.OrderID})
+	if reply.Type != KitchenMessageError || reply.RequestID != "r-3" || reply.Error != "INVALID_COMMAND" {
+		t.Fatalf("invalid command reply = %+v, want INVALID_COMMAND error for r-3", reply)
+	}
+
+	// 주문 생성 이력 뒤에 조리 시작 이력 하나만 남아야 함
+	history := s.orders.history[order.OrderID]
+	if len(history) != 2 || history[1].NewStatus != StatusCooking || history[1].Actor != AdminActor("김철수") {
+		t.Errorf("status history = %+v, want a single COOKING change by %s after creation", history, AdminActor("김철수"))
+	}
+}
+
+func TestKitchenSocketRejectsDisallowedOrigin(t *testing.T) {
+	server := newKitchenServer(t, newTestService(t))
+
+	if _, err := dialKitchen(t, server, "http://evil.example", http.Header{"X-Admin-Password": {kitchenTestPassword}}); err == nil {
+		t.Fatal("dial from disallowed origin succeeded")
+	}
+}
+
+func TestKitchenSocketAcceptsSubprotocolCredentials(t *testing.T) {
+	s := newTestService(t)
+	order := s.addPaidOrder(t)
+	server := newKitchenServer(t, s)
+
+	credential := middleware.AdminPasswordProtocolPrefix + base64.RawURLEncoding.EncodeToString([]byte(kitchenTestPassword))
+
+	if _, err := dialKitchen(t, server, kitchenTestOrigin, nil, KitchenProtocol, middleware.AdminPasswordProtocolPrefix+"d3Jvbmc"); err == nil {
+		t.Fatal("dial with wrong subprotocol password succeeded")
+	}
+
+	conn, err := dialKitchen(t, server, kitchenTestOrigin, nil, KitchenProtocol, credential)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+
+	if got := conn.Config().Protocol; len(got) != 1 || got[0] != KitchenProtocol {
+		t.Errorf("selected protocol = %v, want [%s]", got, KitchenProtocol)
+	}
+
+	reply := sendKitchenCommand(t, conn, KitchenCommand{Type: KitchenMessageCommand, RequestID: "r-1", Command: KitchenCommandStartCooking, OrderID: order.OrderID})
+	if reply.Type != KitchenMessageAck || reply.Applied == nil || !*reply.Applied {
+		t.Fatalf("start_cooking reply = %+v, want applied ack", reply)
+	}
+}
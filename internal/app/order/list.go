@@ -0,0 +1,124 @@
+package order
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 주문 목록 페이지 크기
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// validStatuses 목록 필터에 사용할 수 있는 주문 상태
+var validStatuses = map[string]bool{
+	StatusPending:    true,
+	StatusPaid:       true,
+	StatusCooking:    true,
+	StatusReady:      true,
+	StatusDelivering: true,
+	StatusDelivered:  true,
+	StatusCancelled:  true,
+	StatusRefunded:   true,
+}
+
+// newListFilter 목록 조회 쿼리를 리포지토리 조회 조건으로 변환
+func newListFilter(req ListOrdersRequest) (ListFilter, error) {
+	filter := ListFilter{
+		DeliveryOption: req.DeliveryOption,
+		NamePrefix:     strings.TrimSpace(req.Name),
+		CouponUsed:     req.CouponUsed,
+		MinTotalPrice:  req.MinTotal,
+		Sort:           req.Sort,
+		Limit:          req.Limit,
+	}
+
+	if filter.Sort == "" {
+		filter.Sort = SortCreatedAtDesc
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultListLimit
+	}
+	if filter.Limit > MaxListLimit {
+		filter.Limit = MaxListLimit
+	}
+
+	if req.Status != "" {
+		for _, status := range strings.Split(req.Status, ",") {
+			status = strings.ToUpper(strings.TrimSpace(status))
+			if !validStatuses[status] {
+				return ListFilter{}, errors.BadRequest("INVALID_STATUS", "유효하지 않은 주문 상태입니다.")
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	if req.From != "" {
+		from, _, err := parseListTime(req.From)
+		if err != nil {
+			return ListFilter{}, errors.BadRequest("INVALID_REQUEST", "from 날짜 형식이 올바르지 않습니다.")
+		}
+		filter.CreatedFrom = &from
+	}
+
+	if req.To != "" {
+		to, dateOnly, err := parseListTime(req.To)
+		if err != nil {
+			return ListFilter{}, errors.BadRequest("INVALID_REQUEST", "to 날짜 형식이 올바르지 않습니다.")
+		}
+		// 날짜만 지정하면 해당 날짜 전체를 포함
+		if dateOnly {
+			to = to.AddDate(0, 0, 1)
+		}
+		filter.CreatedTo = &to
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeListCursor(req.Cursor)
+		if err != nil || cursor.Sort != filter.Sort {
+			return ListFilter{}, errors.BadRequest("INVALID_CURSOR", "유효하지 않은 페이지 커서입니다.")
+		}
+		filter.After = cursor
+	}
+
+	return filter, nil
+}
+
+// parseListTime RFC3339 또는 YYYY-MM-DD 형식 파싱 (날짜만 지정했는지 함께 반환)
+func parseListTime(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+
+	t, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return t, true, nil
+}
+
+// encodeListCursor 커서를 URL에 넣을 수 있는 문자열로 변환
+func encodeListCursor(cursor ListCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeListCursor encodeListCursor로 만든 문자열을 커서로 변환
+func decodeListCursor(value string) (*ListCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor ListCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
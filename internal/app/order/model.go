@@ -2,22 +2,52 @@ package order
 
 import (
 	"time"
+
+	"github.com/myramen/be/internal/app/pricing"
 )
 
 type Order struct {
-	OrderID        string    `json:"orderId"`
-	Name           string    `json:"name"`
-	AccountNumber  string    `json:"accountNumber"`
-	Quantity       int       `json:"quantity"`
-	SpicyLevel     int       `json:"spicyLevel"`
-	DeliveryOption string    `json:"deliveryOption"`
-	Options        Options   `json:"options"`
-	TotalPrice     int       `json:"totalPrice"`
-	Status         string    `json:"status"`
-	AppliedCoupon  *Coupon   `json:"appliedCoupon,omitempty"`
-	NewCoupon      *Coupon   `json:"newCoupon,omitempty"`
-	CreatedAt      time.Time `json:"createdAt"`
-	UpdatedAt      time.Time `json:"updatedAt"`
+	ID              int64              `json:"-"`
+	OrderID         string             `json:"orderId"`
+	CustomerID      int64              `json:"customerId,omitempty"` // 로그인해서 주문했거나 연결한 고객 (비로그인 주문은 0)
+	Name            string             `json:"name"`
+	AccountNumber   string             `json:"accountNumber"`
+	ClaimKey        string             `json:"-"` // 이름과 계좌번호의 블라인드 인덱스 (ClaimKey 참고)
+	Quantity        int                `json:"quantity"`
+	Items           []OrderItem        `json:"items"`
+	DeliveryOption  string             `json:"deliveryOption"`
+	Options         Options            `json:"options"`
+	OptionsPrice    int                `json:"optionsPrice"`
+	TotalPrice      int                `json:"totalPrice"`
+	PriceBreakdown  *pricing.Breakdown `json:"priceBreakdown,omitempty"`
+	Status          string             `json:"status"`
+	LookupTokenHash string             `json:"-"`
+	AppliedCoupon   *Coupon            `json:"appliedCoupon,omitempty"`
+	NewCoupon       *Coupon            `json:"newCoupon,omitempty"`
+	CancelReason    string             `json:"cancelReason,omitempty"`
+	CancelledAt     *time.Time         `json:"cancelledAt,omitempty"`
+	Refund          *Refund            `json:"refund,omitempty"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+// OrderItem 주문 항목 (주문 시점의 메뉴 이름과 가격 보관)
+type OrderItem struct {
+	MenuItemID string    `json:"menuItemId"`
+	ItemName   string    `json:"itemName"`
+	Category   string    `json:"category"`
+	UnitPrice  int       `json:"unitPrice"`
+	Quantity   int       `json:"quantity"`
+	SpicyLevel int       `json:"spicyLevel"`
+	Toppings   []Topping `json:"toppings"`
+	LinePrice  int       `json:"linePrice"`
+}
+
+// Topping 주문 항목의 라면 한 개마다 추가하는 토핑
+type Topping struct {
+	MenuItemID string `json:"menuItemId"`
+	Name       string `json:"name"`
+	Price      int    `json:"price"`
 }
 
 type Options struct {
@@ -32,6 +62,33 @@ type Coupon struct {
 	ExpiryDate time.Time `json:"expiryDate,omitempty"`
 }
 
+// Refund 환불 기록 (주문의 계좌번호로 반환한 금액)
+type Refund struct {
+	Amount     int       `json:"amount"`
+	Note       string    `json:"note,omitempty"`
+	RefundedAt time.Time `json:"refundedAt"`
+}
+
+// StatusChange 주문 상태 변경 이력
+type StatusChange struct {
+	PreviousStatus string    `json:"previousStatus,omitempty"`
+	NewStatus      string    `json:"status"`
+	Actor          string    `json:"actor"`
+	Note           string    `json:"note,omitempty"`
+	CreatedAt      time.Time `json:"changedAt"`
+}
+
+// 상태 변경 주체
+const (
+	ActorCustomer = "customer"
+	ActorSystem   = "system"
+)
+
+// AdminActor 관리자 상태 변경 주체
+func AdminActor(name string) string {
+	return "admin:" + name
+}
+
 // Status 상수 정의
 const (
 	StatusPending    = "PENDING"
@@ -40,6 +97,8 @@ const (
 	StatusReady      = "READY"
 	StatusDelivering = "DELIVERING"
 	StatusDelivered  = "DELIVERED"
+	StatusCancelled  = "CANCELLED"
+	StatusRefunded   = "REFUNDED"
 )
 
 // DeliveryOption 상수 정의
@@ -49,11 +108,5 @@ const (
 	DeliveryOptionDelivery      = "DELIVERY"
 )
 
-// 가격 상수 정의
-const (
-	BasePrice           = 4000
-	HotWaterServiceFee  = 500
-	CookingServiceFee   = 500
-	DefaultCouponAmount = 200
-	CouponThreshold     = 3
-)
+// DefaultSpicyLevel 주문 항목의 기본 매운맛 레벨
+const DefaultSpicyLevel = 3
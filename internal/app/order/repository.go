@@ -2,22 +2,72 @@ package order
 
 import (
 	"context"
+	"time"
 )
 
+// 주문 목록 정렬 기준
+const (
+	SortCreatedAtDesc  = "-createdAt"
+	SortCreatedAtAsc   = "createdAt"
+	SortTotalPriceDesc = "-totalPrice"
+	SortTotalPriceAsc  = "totalPrice"
+)
+
+// ListFilter 주문 목록 조회 조건 (비어 있는 조건은 적용하지 않음)
+type ListFilter struct {
+	CustomerID     int64
+	Statuses       []string
+	DeliveryOption string
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	NamePrefix     string
+	CouponUsed     *bool
+	MinTotalPrice  *int
+	Sort           string
+	Limit          int
+	After          *ListCursor
+}
+
+// ListCursor 마지막으로 조회한 주문의 정렬 키 (키셋 페이지네이션)
+type ListCursor struct {
+	Sort       string    `json:"s"`
+	CreatedAt  time.Time `json:"c,omitempty"`
+	TotalPrice int       `json:"p,omitempty"`
+	ID         int64     `json:"i"`
+}
+
 // Repository 주문 리포지토리 인터페이스
 type Repository interface {
 	// Create 새로운 주문 생성
 	Create(ctx context.Context, order *Order) error
-	
+
 	// FindByID 주문 ID로 주문 조회
 	FindByID(ctx context.Context, orderID string) (*Order, error)
+
+	// FindAll 조건에 맞는 주문을 정렬 순서대로 filter.Limit개까지 조회 (filter.After 이후부터)
+	FindAll(ctx context.Context, filter ListFilter) ([]Order, error)
 	
-	// FindAll 모든 주문 조회
-	FindAll(ctx context.Context) ([]Order, error)
+	// Count 조건에 맞는 전체 주문 수 (커서와 개수 제한은 무시)
+	Count(ctx context.Context, filter ListFilter) (int, error)
+
+	// UpdateStatus 주문 상태 업데이트 (현재 상태가 fromStatus일 때만 변경)
+	UpdateStatus(ctx context.Context, orderID string, fromStatus string, status string) error
+
+	// Cancel 주문 취소 처리 (현재 상태가 fromStatus일 때만 변경)
+	Cancel(ctx context.Context, orderID string, fromStatus string, reason string, cancelledAt time.Time) error
+
+	// Refund 취소된 주문의 환불 기록
+	Refund(ctx context.Context, orderID string, refund *Refund) error
+
+	// AddStatusHistory 주문 상태 변경 이력 기록
+	AddStatusHistory(ctx context.Context, orderID string, change *StatusChange) error
 	
-	// UpdateStatus 주문 상태 업데이트
-	UpdateStatus(ctx context.Context, orderID string, status string) error
+	// FindStatusHistory 주문 상태 변경 이력을 오래된 순으로 조회
+	FindStatusHistory(ctx context.Context, orderID string) ([]StatusChange, error)
 	
+	// Claim 고객이 없는 주문 중 claimKey가 같은 주문을 고객에게 연결하고, 연결한 주문을 반환
+	Claim(ctx context.Context, claimKey string, customerID int64) ([]Order, error)
+
 	// Delete 주문 삭제
 	Delete(ctx context.Context, orderID string) error
 }
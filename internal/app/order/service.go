@@ -3,9 +3,18 @@ package order
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/myramen/be/internal/app/campaign"
 	"github.com/myramen/be/internal/app/coupon"
+	"github.com/myramen/be/internal/app/inventory"
+	"github.com/myramen/be/internal/app/menu"
+	"github.com/myramen/be/internal/app/pricing"
+	"github.com/myramen/be/internal/pkg/db"
+	"github.com/myramen/be/internal/pkg/encryption"
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/idgen"
 	"github.com/myramen/be/internal/pkg/utils/errors"
 )
 
@@ -13,13 +22,29 @@ import (
 type Service struct {
 	orderRepo  Repository
 	couponRepo coupon.Repository
+	menuRepo   menu.Repository
+	stock      *inventory.Service
+	prices     *pricing.Service
+	campaigns  *campaign.Service
+	claims     *encryption.BlindIndex
+	tx         db.Transactor
+	ids        idgen.Generator
+	events     event.Recorder
 }
 
 // NewService 주문 서비스 생성
-func NewService(orderRepo Repository, couponRepo coupon.Repository) *Service {
+func NewService(orderRepo Repository, couponRepo coupon.Repository, menuRepo menu.Repository, stock *inventory.Service, prices *pricing.Service, campaigns *campaign.Service, claims *encryption.BlindIndex, tx db.Transactor, ids idgen.Generator, events event.Recorder) *Service {
 	return &Service{
 		orderRepo:  orderRepo,
 		couponRepo: couponRepo,
+		menuRepo:   menuRepo,
+		stock:      stock,
+		prices:     prices,
+		campaigns:  campaigns,
+		claims:     claims,
+		tx:         tx,
+		ids:        ids,
+		events:     events,
 	}
 }
 
@@ -27,164 +52,548 @@ func NewService(orderRepo Repository, couponRepo coupon.Repository) *Service {
 func (s *Service) CreateOrder(ctx context.Context, req CreateOrderRequest) (*OrderResponse, error) {
 	// 기본 주문 정보 설정
 	newOrder := &Order{
-		OrderID:        generateOrderID(),
-		Name:           req.Name,
-		AccountNumber:  req.AccountNumber,
-		Quantity:       req.Quantity,
-		SpicyLevel:     req.SpicyLevel,
-		DeliveryOption: req.DeliveryOption,
-		Options:        req.Options,
-		Status:         StatusPending,
-		CreatedAt:      time.Now(),
-		UpdatedAt:      time.Now(),
+		OrderID:       s.ids.NewOrderID(),
+		CustomerID:    req.CustomerID,
+		Name:          req.Name,
+		AccountNumber: req.AccountNumber,
+		ClaimKey:      ClaimKey(s.claims, req.Name, req.AccountNumber),
+		Status:        StatusPending,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
 	}
 
-	// 가격 계산
-	newOrder.TotalPrice = calculateTotalPrice(newOrder)
+	// 고객 조회 토큰 발급 (원문은 이번 응답에서만 반환)
+	lookupToken := idgen.NewToken()
+	newOrder.LookupTokenHash = idgen.HashToken(lookupToken)
 
-	// 쿠폰 적용 처리
-	if req.CouponID != "" {
-		couponData, err := s.couponRepo.FindByID(ctx, req.CouponID)
-		if err != nil {
-			return nil, err
+	// 주문 항목과 옵션 요금 (주문 시점의 메뉴 가격 보관)
+	fees, err := s.prepareOrder(ctx, newOrder, req.OrderDraftRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &StatusChange{
+		NewStatus: StatusPending,
+		Actor:     ActorCustomer,
+		CreatedAt: newOrder.CreatedAt,
+	}
+
+	// 쿠폰 사용, 신규 쿠폰 발급, 주문 저장을 하나의 트랜잭션으로 처리
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		// 재고 차감 (부족하면 OUT_OF_STOCK)
+		if err := s.stock.Reserve(ctx, newOrder.OrderID, orderUnits(newOrder), ActorCustomer); err != nil {
+			return err
 		}
 
-		if couponData == nil {
-			return nil, errors.BadRequest("INVALID_COUPON", "사용할 수 없는 쿠폰입니다.")
+		// 쿠폰 확인 (사용 조건에 맞지 않으면 이유를 담은 에러)
+		var couponData *coupon.Coupon
+		if req.CouponID != "" {
+			var err error
+			couponData, err = s.checkCoupon(ctx, req.CouponID, newOrder)
+			if err != nil {
+				return err
+			}
 		}
 
-		if couponData.IsUsed {
-			return nil, errors.BadRequest("INVALID_COUPON", "이미 사용된 쿠폰입니다.")
+		// 가격 계산 (쿠폰 할인 포함, 0원 미만으로 내려가지 않음)
+		if err := s.priceOrder(ctx, newOrder, fees, couponData); err != nil {
+			return err
 		}
 
-		if time.Now().After(couponData.ExpiryDate) {
-			return nil, errors.BadRequest("INVALID_COUPON", "만료된 쿠폰입니다.")
+		if couponData != nil {
+			// 캠페인 쿠폰이면 예산과 사용 한도 차감 (부족하면 CAMPAIGN_* 에러)
+			if couponData.CampaignID != 0 {
+				if err := s.campaigns.Spend(ctx, campaignRedemption(newOrder, couponData)); err != nil {
+					return err
+				}
+			}
+
+			// 쿠폰 사용 처리 (동시 주문 중 하나만 성공)
+			if err := s.couponRepo.Redeem(ctx, couponData.CouponID, newOrder.OrderID, newOrder.CreatedAt); err != nil {
+				return err
+			}
+
+			if err := s.recordCouponEvent(ctx, event.CouponRedeemed, &coupon.CouponEvent{
+				CouponID:   couponData.CouponID,
+				OrderID:    newOrder.OrderID,
+				Discount:   newOrder.AppliedCoupon.Discount,
+				OccurredAt: newOrder.CreatedAt,
+			}); err != nil {
+				return err
+			}
 		}
 
-		// 쿠폰 할인 적용
-		newOrder.AppliedCoupon = &Coupon{
-			CouponID: couponData.CouponID,
-			Discount: couponData.Discount,
+		// 구매 보상 캠페인 조건을 채우면 신규 쿠폰 발급 (예산이 소진된 캠페인은 발급하지 않음)
+		reward, err := s.campaigns.RewardFor(ctx, newOrder.Quantity, newOrder.CreatedAt)
+		if err != nil {
+			return err
 		}
 
-		newOrder.TotalPrice -= couponData.Discount
+		if reward != nil {
+			newCoupon := &coupon.Coupon{
+				CouponID:   s.ids.NewCouponID(),
+				CustomerID: newOrder.CustomerID,
+				CampaignID: reward.ID,
+				Type:       coupon.TypeFixed,
+				Discount:   reward.Reward.Discount,
+				ExpiryDate: rewardExpiryDate(reward, newOrder.CreatedAt),
+				IsUsed:     false,
+				IssuedAt:   newOrder.CreatedAt,
+			}
 
-		// 쿠폰 사용 처리
-		if err := s.couponRepo.MarkAsUsed(ctx, req.CouponID); err != nil {
-			return nil, err
+			// 비로그인 주문의 쿠폰은 같은 이름과 계좌번호로 주문할 때만 사용 가능
+			if newOrder.CustomerID == 0 {
+				newCoupon.OwnerKey = newOrder.ClaimKey
+			}
+
+			if err := s.couponRepo.Create(ctx, newCoupon); err != nil {
+				return err
+			}
+
+			newOrder.NewCoupon = &Coupon{
+				CouponID:   newCoupon.CouponID,
+				Discount:   newCoupon.Discount,
+				ExpiryDate: newCoupon.ExpiryDate,
+			}
+
+			if err := s.recordCouponEvent(ctx, event.CouponIssued, &coupon.CouponEvent{
+				CouponID:   newCoupon.CouponID,
+				OrderID:    newOrder.OrderID,
+				Discount:   newCoupon.Discount,
+				ExpiryDate: &newCoupon.ExpiryDate,
+				OccurredAt: newCoupon.IssuedAt,
+			}); err != nil {
+				return err
+			}
 		}
+
+		// 주문 저장
+		if err := s.orderRepo.Create(ctx, newOrder); err != nil {
+			return err
+		}
+
+		if err := s.orderRepo.AddStatusHistory(ctx, newOrder.OrderID, created); err != nil {
+			return err
+		}
+
+		return s.recordOrderEvent(ctx, event.OrderCreated, newOrder, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 응답 생성
+	response := newCustomerOrderResponse(newOrder)
+	response.NewCoupon = newOrder.NewCoupon
+	response.LookupToken = lookupToken
+	return response, nil
+}
+
+// QuoteOrder 주문 견적 계산
+// CreateOrder와 같은 검증과 가격 계산을 거치지만 주문 저장, 재고 차감, 쿠폰 사용은 하지 않는다.
+func (s *Service) QuoteOrder(ctx context.Context, req QuoteOrderRequest) (*QuoteResponse, error) {
+	draft := &Order{CustomerID: req.CustomerID, CreatedAt: time.Now()}
+	if req.Name != "" && req.AccountNumber != "" {
+		draft.ClaimKey = ClaimKey(s.claims, req.Name, req.AccountNumber)
+	}
+
+	fees, err := s.prepareOrder(ctx, draft, req.OrderDraftRequest)
+	if err != nil {
+		return nil, err
 	}
 
-	// 3개 이상 주문 시 신규 쿠폰 발급
-	if req.Quantity >= CouponThreshold {
-		newCoupon := &coupon.Coupon{
-			CouponID:   generateCouponID(),
-			Discount:   DefaultCouponAmount,
-			ExpiryDate: time.Now().Add(coupon.ExpiryDuration),
-			IsUsed:     false,
-			IssuedAt:   time.Now(),
+	// 재고 확인 (부족하면 주문과 같은 OUT_OF_STOCK)
+	if err := s.stock.Check(ctx, orderUnits(draft)); err != nil {
+		return nil, err
+	}
+
+	// 쿠폰을 쓸 수 없어도 견적은 계산하고 이유를 함께 반환
+	var couponQuote *CouponQuote
+	if req.CouponID != "" {
+		couponQuote = &CouponQuote{CouponID: idgen.NormalizeCouponID(req.CouponID)}
+
+		couponData, err := s.checkCoupon(ctx, req.CouponID, draft)
+		if err == nil {
+			err = s.priceOrder(ctx, draft, fees, couponData)
+		}
+
+		if err == nil && couponData.CampaignID != 0 {
+			err = s.campaigns.CheckSpend(ctx, campaignRedemption(draft, couponData))
 		}
 
-		if err := s.couponRepo.Create(ctx, newCoupon); err != nil {
+		if err != nil {
+			customErr, ok := err.(errors.CustomError)
+			if !ok || customErr.Status >= errors.StatusInternalServer {
+				return nil, err
+			}
+
+			couponQuote.Reason = customErr.Code
+			couponQuote.Message = customErr.Message
+			couponQuote.Details = customErr.Details
+		} else {
+			couponQuote.Applicable = true
+			couponQuote.Discount = draft.AppliedCoupon.Discount
+		}
+	}
+
+	if couponQuote == nil || !couponQuote.Applicable {
+		draft.AppliedCoupon = nil
+		if err := s.priceOrder(ctx, draft, fees, nil); err != nil {
 			return nil, err
 		}
+	}
 
-		newOrder.NewCoupon = &Coupon{
-			CouponID:   newCoupon.CouponID,
-			Discount:   newCoupon.Discount,
-			ExpiryDate: newCoupon.ExpiryDate,
+	response := &QuoteResponse{
+		Quantity:       draft.Quantity,
+		Items:          draft.Items,
+		DeliveryOption: draft.DeliveryOption,
+		Options:        draft.Options,
+		OptionsPrice:   draft.OptionsPrice,
+		TotalPrice:     draft.TotalPrice,
+		PriceBreakdown: draft.PriceBreakdown,
+		Coupon:         couponQuote,
+	}
+
+	reward, err := s.campaigns.RewardFor(ctx, draft.Quantity, draft.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if reward != nil {
+		response.RewardCoupon = &Coupon{
+			Discount:   reward.Reward.Discount,
+			ExpiryDate: rewardExpiryDate(reward, draft.CreatedAt),
 		}
 	}
 
-	// 주문 저장
-	if err := s.orderRepo.Create(ctx, newOrder); err != nil {
+	return response, nil
+}
+
+// prepareOrder 요청의 주문 항목과 옵션을 검증해서 주문에 채우고 옵션 요금 목록을 반환
+func (s *Service) prepareOrder(ctx context.Context, order *Order, req OrderDraftRequest) ([]pricing.Option, error) {
+	lines, err := req.lineItems()
+	if err != nil {
+		return nil, err
+	}
+
+	order.Items, err = s.buildOrderItems(ctx, lines)
+	if err != nil {
 		return nil, err
 	}
+	order.Quantity = totalQuantity(order.Items)
+	order.DeliveryOption = req.DeliveryOption
+	order.Options = req.Options
 
-	// 응답 생성
-	return &OrderResponse{
-		OrderID:        newOrder.OrderID,
-		Name:           newOrder.Name,
-		AccountNumber:  newOrder.AccountNumber,
-		Quantity:       newOrder.Quantity,
-		SpicyLevel:     newOrder.SpicyLevel,
-		DeliveryOption: newOrder.DeliveryOption,
-		Options:        newOrder.Options,
-		TotalPrice:     newOrder.TotalPrice,
-		Status:         newOrder.Status,
-		AppliedCoupon:  newOrder.AppliedCoupon,
-		NewCoupon:      newOrder.NewCoupon,
-	}, nil
+	fees, err := s.optionFees(ctx, req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	order.OptionsPrice = 0
+	for _, fee := range fees {
+		order.OptionsPrice += fee.Price
+	}
+
+	return fees, nil
 }
 
-// GetOrderByID 주문 ID로 주문 조회
-func (s *Service) GetOrderByID(ctx context.Context, orderID string) (*OrderResponse, error) {
-	order, err := s.orderRepo.FindByID(ctx, orderID)
+// checkCoupon 주문에 사용할 수 있는 쿠폰인지 확인 (소유자가 있는 쿠폰은 소유자의 주문에만 사용)
+func (s *Service) checkCoupon(ctx context.Context, couponID string, order *Order) (*coupon.Coupon, error) {
+	couponID = idgen.NormalizeCouponID(couponID)
+	if !idgen.IsCouponID(couponID) {
+		return nil, coupon.ErrCouponNotFound
+	}
+
+	couponData, err := s.couponRepo.FindByID(ctx, couponID)
 	if err != nil {
 		return nil, err
 	}
 
-	if order == nil {
-		return nil, errors.NotFound("NOT_FOUND", "해당 주문을 찾을 수 없습니다.")
+	if couponData == nil {
+		return nil, coupon.ErrCouponNotFound
 	}
 
-	return &OrderResponse{
+	// 다른 사람의 쿠폰은 없는 쿠폰과 같은 오류로 응답해서 쿠폰 코드가 있는지 드러내지 않음
+	if !couponData.UsableBy(order.CustomerID, order.ClaimKey) {
+		return nil, coupon.ErrCouponNotFound
+	}
+
+	if couponData.IsUsed {
+		return nil, coupon.ErrCouponAlreadyRedeemed
+	}
+
+	if couponData.RevokedAt != nil {
+		return nil, coupon.ErrCouponRevoked
+	}
+
+	if order.CreatedAt.After(couponData.ExpiryDate) {
+		return nil, coupon.ErrCouponExpired
+	}
+
+	return couponData, nil
+}
+
+// priceOrder 가격 규칙과 쿠폰으로 주문 금액 계산 (쿠폰이 있으면 실제 할인 금액을 적용 쿠폰에 기록)
+func (s *Service) priceOrder(ctx context.Context, order *Order, fees []pricing.Option, couponData *coupon.Coupon) error {
+	breakdown, err := s.prices.Quote(ctx, pricingDraft(order, fees, couponData))
+	if err != nil {
+		return err
+	}
+
+	order.PriceBreakdown = breakdown
+	order.TotalPrice = breakdown.Total
+
+	if couponData != nil {
+		order.AppliedCoupon = &Coupon{
+			CouponID: couponData.CouponID,
+			Discount: breakdown.CouponDiscount(),
+		}
+	}
+
+	return nil
+}
+
+// rewardExpiryDate 구매 보상 쿠폰의 만료일 (발급일로부터 캠페인의 유효 기간)
+func rewardExpiryDate(reward *campaign.Campaign, issuedAt time.Time) time.Time {
+	return issuedAt.AddDate(0, 0, reward.Reward.ValidDays)
+}
+
+// campaignRedemption 주문에 적용한 캠페인 쿠폰의 사용 기록
+func campaignRedemption(order *Order, couponData *coupon.Coupon) *campaign.Redemption {
+	return &campaign.Redemption{
+		CampaignID:  couponData.CampaignID,
+		CouponID:    couponData.CouponID,
+		OrderID:     order.OrderID,
+		CustomerKey: campaign.CustomerKey(order.CustomerID, order.ClaimKey),
+		Amount:      order.AppliedCoupon.Discount,
+		CreatedAt:   order.CreatedAt,
+	}
+}
+
+// GetPublicOrder 조회 토큰 없이 공개 가능한 주문 정보 조회
+func (s *Service) GetPublicOrder(ctx context.Context, orderID string) (*PublicOrderResponse, error) {
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicOrderResponse{
 		OrderID:        order.OrderID,
-		Name:           order.Name,
-		AccountNumber:  order.AccountNumber,
 		Quantity:       order.Quantity,
-		SpicyLevel:     order.SpicyLevel,
 		DeliveryOption: order.DeliveryOption,
-		Options:        order.Options,
-		TotalPrice:     order.TotalPrice,
 		Status:         order.Status,
-		AppliedCoupon:  order.AppliedCoupon,
+		CreatedAt:      order.CreatedAt,
 	}, nil
 }
 
-// GetAllOrders 모든 주문 조회
-func (s *Service) GetAllOrders(ctx context.Context) (*OrderListResponse, error) {
-	orders, err := s.orderRepo.FindAll(ctx)
+// GetCustomerOrder 조회 토큰을 확인한 뒤 주문 전체 정보 조회
+func (s *Service) GetCustomerOrder(ctx context.Context, orderID string, token string) (*OrderResponse, error) {
+	order, err := s.findCustomerOrder(ctx, orderID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.orderRepo.FindStatusHistory(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 고객에게는 처리 주체와 메모를 제외한 시간표만 제공
+	timeline := make([]StatusHistoryEntry, 0, len(history))
+	for _, change := range history {
+		timeline = append(timeline, StatusHistoryEntry{
+			PreviousStatus: change.PreviousStatus,
+			Status:         change.NewStatus,
+			ChangedAt:      change.CreatedAt,
+		})
+	}
+
+	response := newCustomerOrderResponse(order)
+	response.Timeline = timeline
+	return response, nil
+}
+
+// GetCustomerOrderSnapshot 조회 토큰을 확인한 뒤 현재 주문 상태 조회 (실시간 구독 시작용)
+func (s *Service) GetCustomerOrderSnapshot(ctx context.Context, orderID string, token string) (*CustomerOrderEvent, error) {
+	order, err := s.findCustomerOrder(ctx, orderID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CustomerOrderEvent{
+		OrderID:   order.OrderID,
+		Status:    order.Status,
+		ChangedAt: order.UpdatedAt,
+	}, nil
+}
+
+// GetOrderByID 주문 ID로 주문 조회 (관리자용)
+func (s *Service) GetOrderByID(ctx context.Context, orderID string) (*OrderResponse, error) {
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOrderResponse(order), nil
+}
+
+// GetAllOrders 조건에 맞는 주문 목록을 페이지 단위로 조회
+func (s *Service) GetAllOrders(ctx context.Context, req ListOrdersRequest) (*OrderListResponse, error) {
+	filter, err := newListFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listOrders(ctx, filter, newOrderResponse)
+}
+
+// GetCustomerOrders 로그인한 고객의 주문 목록을 최신순으로 조회 (계좌번호 마스킹)
+func (s *Service) GetCustomerOrders(ctx context.Context, customerID int64, req CustomerOrdersRequest) (*OrderListResponse, error) {
+	filter, err := newListFilter(ListOrdersRequest{
+		Status: req.Status,
+		Limit:  req.Limit,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	filter.CustomerID = customerID
+
+	return s.listOrders(ctx, filter, newCustomerOrderResponse)
+}
+
+// listOrders 주문 목록 페이지 조회
+func (s *Service) listOrders(ctx context.Context, filter ListFilter, newResponse func(*Order) *OrderResponse) (*OrderListResponse, error) {
+	// 다음 페이지 존재 여부 확인을 위해 한 건 더 조회
+	limit := filter.Limit
+	filter.Limit = limit + 1
+
+	orders, err := s.orderRepo.FindAll(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	totalCount, err := s.orderRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if len(orders) > limit {
+		orders = orders[:limit]
+		last := orders[len(orders)-1]
+		nextCursor = encodeListCursor(ListCursor{
+			Sort:       filter.Sort,
+			CreatedAt:  last.CreatedAt,
+			TotalPrice: last.TotalPrice,
+			ID:         last.ID,
+		})
+	}
+
 	orderResponses := make([]OrderResponse, 0, len(orders))
 	for _, order := range orders {
-		orderResponses = append(orderResponses, OrderResponse{
-			OrderID:        order.OrderID,
-			Name:           order.Name,
-			AccountNumber:  order.AccountNumber,
-			Quantity:       order.Quantity,
-			SpicyLevel:     order.SpicyLevel,
-			DeliveryOption: order.DeliveryOption,
-			Options:        order.Options,
-			TotalPrice:     order.TotalPrice,
-			Status:         order.Status,
-			AppliedCoupon:  order.AppliedCoupon,
-		})
+		orderResponses = append(orderResponses, *newResponse(&order))
 	}
 
 	return &OrderListResponse{
-		Orders: orderResponses,
+		Orders:     orderResponses,
+		NextCursor: nextCursor,
+		TotalCount: totalCount,
 	}, nil
 }
 
-// UpdateOrderStatus 주문 상태 업데이트
-func (s *Service) UpdateOrderStatus(ctx context.Context, orderID string, status string) (*OrderResponse, error) {
-	order, err := s.orderRepo.FindByID(ctx, orderID)
+// ClaimOrders 이름과 계좌번호가 같은 비로그인 주문을 고객 계정에 연결
+// 주문으로 발급된 구매 보상 쿠폰도 함께 연결한다. 이미 다른 계정에 연결된 주문은 건드리지 않는다.
+func (s *Service) ClaimOrders(ctx context.Context, customerID int64, req ClaimOrdersRequest) (*ClaimOrdersResponse, error) {
+	claimKey := ClaimKey(s.claims, req.Name, req.AccountNumber)
+
+	var claimed []Order
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		claimed, err = s.orderRepo.Claim(ctx, claimKey, customerID)
+		if err != nil {
+			return err
+		}
+
+		for _, order := range claimed {
+			if order.NewCoupon == nil {
+				continue
+			}
+
+			if err := s.couponRepo.AssignCustomer(ctx, order.NewCoupon.CouponID, customerID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if order == nil {
-		return nil, errors.NotFound("NOT_FOUND", "해당 주문을 찾을 수 없습니다.")
+	orderResponses := make([]OrderResponse, 0, len(claimed))
+	for _, order := range claimed {
+		orderResponses = append(orderResponses, *newCustomerOrderResponse(&order))
 	}
 
-	// 상태 유효성 검사 - 이미 handler에서 확인함
+	return &ClaimOrdersResponse{
+		ClaimedCount: len(claimed),
+		Orders:       orderResponses,
+	}, nil
+}
 
-	// 상태 업데이트
-	if err := s.orderRepo.UpdateStatus(ctx, orderID, status); err != nil {
+// UpdateOrderStatus 주문 상태 업데이트 (변경 이력을 같은 트랜잭션으로 기록)
+func (s *Service) UpdateOrderStatus(ctx context.Context, orderID string, status string, actor string, note string) (*OrderResponse, error) {
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 취소는 쿠폰 복원이 필요하므로 별도 흐름으로 처리
+	if status == StatusCancelled {
+		return s.cancelOrder(ctx, order, note, actor, true)
+	}
+
+	if status == StatusRefunded {
+		return nil, errors.BadRequest("INVALID_STATUS", "환불은 환불 요청으로만 처리할 수 있습니다.")
+	}
+
+	// 상태 전이 검사
+	if !CanTransition(order, status) {
+		allowed := AllowedTransitions(order)
+		return nil, errors.NewError(
+			errors.StatusConflict,
+			"INVALID_TRANSITION",
+			fmt.Sprintf("%s 상태에서 %s 상태로 변경할 수 없습니다.", order.Status, status),
+			map[string]interface{}{
+				"currentStatus":      order.Status,
+				"requestedStatus":    status,
+				"allowedTransitions": allowed,
+			},
+		)
+	}
+
+	change := &StatusChange{
+		PreviousStatus: order.Status,
+		NewStatus:      status,
+		Actor:          actor,
+		Note:           note,
+		CreatedAt:      time.Now(),
+	}
+
+	// 상태 업데이트와 이력 기록
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.orderRepo.UpdateStatus(ctx, orderID, order.Status, status); err != nil {
+			return err
+		}
+
+		if err := s.orderRepo.AddStatusHistory(ctx, orderID, change); err != nil {
+			return err
+		}
+
+		return s.recordOrderEvent(ctx, event.OrderStatusChanged, order, change)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -194,47 +603,630 @@ func (s *Service) UpdateOrderStatus(ctx context.Context, orderID string, status
 		return nil, err
 	}
 
+	return newOrderResponse(order), nil
+}
+
+// EnsureOrderStatus 주문을 해당 상태로 변경 (이미 그 상태이면 변경하지 않고 applied=false 반환)
+// 같은 명령이 두 번 들어오거나 다른 화면에서 먼저 처리한 경우에도 오류 없이 현재 주문을 반환한다.
+func (s *Service) EnsureOrderStatus(ctx context.Context, orderID string, status string, actor string, note string) (*OrderResponse, bool, error) {
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if order.Status == status {
+		return newOrderResponse(order), false, nil
+	}
+
+	response, err := s.UpdateOrderStatus(ctx, orderID, status, actor, note)
+	if err == nil {
+		return response, true, nil
+	}
+
+	// 동시에 같은 상태로 변경된 경우 성공으로 취급
+	current, findErr := s.findOrder(ctx, orderID)
+	if findErr == nil && current.Status == status {
+		return newOrderResponse(current), false, nil
+	}
+
+	return nil, false, err
+}
+
+// CancelOrderByCustomer 조회 토큰을 확인한 뒤 고객 주문 취소 (결제 전 주문만 가능)
+func (s *Service) CancelOrderByCustomer(ctx context.Context, orderID string, token string, reason string) (*OrderResponse, error) {
+	order, err := s.findCustomerOrder(ctx, orderID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.cancelOrder(ctx, order, reason, ActorCustomer, false)
+	if err != nil {
+		return nil, err
+	}
+
+	response.AccountNumber = maskAccountNumber(response.AccountNumber)
+	return response, nil
+}
+
+// CancelOrder 관리자 주문 취소 (완료되지 않은 모든 주문 취소 가능)
+func (s *Service) CancelOrder(ctx context.Context, orderID string, reason string, actor string) (*OrderResponse, error) {
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.cancelOrder(ctx, order, reason, actor, true)
+}
+
+// cancelOrder 주문 취소, 사용한 쿠폰 복원, 이력 기록
+func (s *Service) cancelOrder(ctx context.Context, order *Order, reason string, actor string, byAdmin bool) (*OrderResponse, error) {
+	orderID := order.OrderID
+
+	if !byAdmin && !CanCustomerCancel(order) {
+		return nil, errors.NewError(
+			errors.StatusConflict,
+			"INVALID_TRANSITION",
+			"결제가 완료된 주문은 직접 취소할 수 없습니다. 관리자에게 문의해주세요.",
+			map[string]interface{}{
+				"currentStatus":   order.Status,
+				"requestedStatus": StatusCancelled,
+			},
+		)
+	}
+
+	if !CanTransition(order, StatusCancelled) {
+		return nil, errors.NewError(
+			errors.StatusConflict,
+			"INVALID_TRANSITION",
+			fmt.Sprintf("%s 상태의 주문은 취소할 수 없습니다.", order.Status),
+			map[string]interface{}{
+				"currentStatus":      order.Status,
+				"requestedStatus":    StatusCancelled,
+				"allowedTransitions": AllowedTransitions(order),
+			},
+		)
+	}
+
+	change := &StatusChange{
+		PreviousStatus: order.Status,
+		NewStatus:      StatusCancelled,
+		Actor:          actor,
+		Note:           reason,
+		CreatedAt:      time.Now(),
+	}
+
+	// 주문 취소, 쿠폰과 재고 복원, 이력 기록을 하나의 트랜잭션으로 처리
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.orderRepo.Cancel(ctx, orderID, order.Status, reason, change.CreatedAt); err != nil {
+			return err
+		}
+
+		if err := s.orderRepo.AddStatusHistory(ctx, orderID, change); err != nil {
+			return err
+		}
+
+		cancelled := *order
+		cancelled.CancelReason = reason
+		cancelled.CancelledAt = &change.CreatedAt
+		if err := s.recordOrderEvent(ctx, event.OrderStatusChanged, &cancelled, change); err != nil {
+			return err
+		}
+
+		// 사용한 쿠폰 복원 (캠페인 쿠폰이면 예산과 사용 횟수도 되돌림)
+		if order.AppliedCoupon != nil {
+			if err := s.couponRepo.Release(ctx, order.AppliedCoupon.CouponID, order.OrderID); err != nil {
+				return err
+			}
+
+			if err := s.campaigns.Refund(ctx, orderID, change.CreatedAt); err != nil {
+				return err
+			}
+		}
+
+		// 이 주문으로 발급한 구매 보상 쿠폰 회수
+		if order.NewCoupon != nil {
+			if err := s.revokeRewardCoupon(ctx, order, actor, byAdmin, change.CreatedAt); err != nil {
+				return err
+			}
+		}
+
+		// 차감한 재고 복원
+		return s.stock.Release(ctx, orderID, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = s.orderRepo.FindByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOrderResponse(order), nil
+}
+
+// revokeRewardCoupon 취소하는 주문으로 발급한 구매 보상 쿠폰을 사용 중지 (트랜잭션 안에서 호출)
+// 보상 쿠폰을 이미 다른 주문에 사용했으면 고객 취소는 거절하고, 관리자 취소는 진행하되 쿠폰 감사 기록에 남긴다.
+func (s *Service) revokeRewardCoupon(ctx context.Context, order *Order, actor string, byAdmin bool, at time.Time) error {
+	reward, err := s.couponRepo.FindByIDForUpdate(ctx, order.NewCoupon.CouponID)
+	if err != nil {
+		return err
+	}
+
+	if reward == nil || reward.RevokedAt != nil {
+		return nil
+	}
+
+	if reward.IsUsed {
+		if !byAdmin {
+			return ErrRewardCouponRedeemed(reward)
+		}
+
+		return s.couponRepo.AddAuditEntry(ctx, &coupon.AuditEntry{
+			CouponID: reward.CouponID,
+			Action:   coupon.AuditSourceOrderCancelled,
+			Actor:    actor,
+			Details: map[string]interface{}{
+				"orderId":       order.OrderID,
+				"usedByOrderId": reward.UsedByOrderID,
+			},
+			CreatedAt: at,
+		})
+	}
+
+	reward.RevokedAt = &at
+	reward.RevokedReason = coupon.ReasonSourceOrderCancelled
+	if err := s.couponRepo.Update(ctx, reward); err != nil {
+		return err
+	}
+
+	if err := s.couponRepo.AddAuditEntry(ctx, &coupon.AuditEntry{
+		CouponID: reward.CouponID,
+		Action:   coupon.AuditRevoked,
+		Actor:    actor,
+		Details: map[string]interface{}{
+			"reason":  reward.RevokedReason,
+			"orderId": order.OrderID,
+		},
+		CreatedAt: at,
+	}); err != nil {
+		return err
+	}
+
+	return s.recordCouponEvent(ctx, event.CouponRevoked, &coupon.CouponEvent{
+		CouponID:   reward.CouponID,
+		OrderID:    order.OrderID,
+		Discount:   reward.Discount,
+		Reason:     reward.RevokedReason,
+		OccurredAt: at,
+	})
+}
+
+// RefundOrder 취소된 주문의 환불 기록 (amount가 nil이면 결제 금액 전액)
+func (s *Service) RefundOrder(ctx context.Context, orderID string, amount *int, note string, actor string) (*OrderResponse, error) {
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !CanTransition(order, StatusRefunded) {
+		return nil, errors.NewError(
+			errors.StatusConflict,
+			"INVALID_TRANSITION",
+			"취소된 주문만 환불할 수 있습니다.",
+			map[string]interface{}{
+				"currentStatus":      order.Status,
+				"requestedStatus":    StatusRefunded,
+				"allowedTransitions": AllowedTransitions(order),
+			},
+		)
+	}
+
+	refundAmount := order.TotalPrice
+	if amount != nil {
+		refundAmount = *amount
+	}
+
+	if refundAmount < 0 || refundAmount > order.TotalPrice {
+		return nil, errors.BadRequest("INVALID_REFUND_AMOUNT", "환불 금액은 0원 이상, 주문 금액 이하이어야 합니다.")
+	}
+
+	refund := &Refund{
+		Amount:     refundAmount,
+		Note:       note,
+		RefundedAt: time.Now(),
+	}
+
+	historyNote := fmt.Sprintf("%d원 환불", refund.Amount)
+	if note != "" {
+		historyNote += " - " + note
+	}
+
+	change := &StatusChange{
+		PreviousStatus: StatusCancelled,
+		NewStatus:      StatusRefunded,
+		Actor:          actor,
+		Note:           historyNote,
+		CreatedAt:      refund.RefundedAt,
+	}
+
+	// 환불 기록과 이력 기록
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.orderRepo.Refund(ctx, orderID, refund); err != nil {
+			return err
+		}
+
+		if err := s.orderRepo.AddStatusHistory(ctx, orderID, change); err != nil {
+			return err
+		}
+
+		refunded := *order
+		refunded.Refund = refund
+		return s.recordOrderEvent(ctx, event.OrderStatusChanged, &refunded, change)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = s.orderRepo.FindByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOrderResponse(order), nil
+}
+
+// GetStatusHistory 주문 상태 변경 이력 조회 (관리자용, 처리 주체와 메모 포함)
+func (s *Service) GetStatusHistory(ctx context.Context, orderID string) (*StatusHistoryResponse, error) {
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.orderRepo.FindStatusHistory(ctx, order.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]StatusHistoryEntry, 0, len(history))
+	for _, change := range history {
+		entries = append(entries, StatusHistoryEntry{
+			PreviousStatus: change.PreviousStatus,
+			Status:         change.NewStatus,
+			Actor:          change.Actor,
+			Note:           change.Note,
+			ChangedAt:      change.CreatedAt,
+		})
+	}
+
+	return &StatusHistoryResponse{
+		OrderID: order.OrderID,
+		Status:  order.Status,
+		History: entries,
+	}, nil
+}
+
+// GetAllowedTransitions 주문의 다음 상태 후보 조회
+func (s *Service) GetAllowedTransitions(ctx context.Context, orderID string) (*TransitionsResponse, error) {
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransitionsResponse{
+		OrderID:            order.OrderID,
+		Status:             order.Status,
+		DeliveryOption:     order.DeliveryOption,
+		AllowedTransitions: AllowedTransitions(order),
+	}, nil
+}
+
+// findOrder 주문 조회 (형식이 맞지 않거나 존재하지 않으면 NOT_FOUND)
+func (s *Service) findOrder(ctx context.Context, orderID string) (*Order, error) {
+	if !idgen.IsOrderID(orderID) {
+		return nil, ErrOrderNotFound
+	}
+
+	order, err := s.orderRepo.FindByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, ErrOrderNotFound
+	}
+
+	return order, nil
+}
+
+// findCustomerOrder 조회 토큰을 확인한 뒤 주문 조회
+func (s *Service) findCustomerOrder(ctx context.Context, orderID string, token string) (*Order, error) {
+	if token == "" {
+		return nil, ErrLookupTokenRequired
+	}
+
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !idgen.VerifyToken(token, order.LookupTokenHash) {
+		return nil, ErrInvalidLookupToken
+	}
+
+	return order, nil
+}
+
+// newOrderResponse 주문 모델을 응답 DTO로 변환
+func newOrderResponse(order *Order) *OrderResponse {
 	return &OrderResponse{
 		OrderID:        order.OrderID,
+		CustomerID:     order.CustomerID,
 		Name:           order.Name,
 		AccountNumber:  order.AccountNumber,
 		Quantity:       order.Quantity,
-		SpicyLevel:     order.SpicyLevel,
+		Items:          order.Items,
 		DeliveryOption: order.DeliveryOption,
 		Options:        order.Options,
+		OptionsPrice:   order.OptionsPrice,
 		TotalPrice:     order.TotalPrice,
+		PriceBreakdown: order.PriceBreakdown,
 		Status:         order.Status,
 		AppliedCoupon:  order.AppliedCoupon,
-	}, nil
+		CancelReason:   order.CancelReason,
+		CancelledAt:    order.CancelledAt,
+		Refund:         order.Refund,
+	}
+}
+
+// newCustomerOrderResponse 고객용 주문 응답 (계좌번호 마스킹)
+func newCustomerOrderResponse(order *Order) *OrderResponse {
+	response := newOrderResponse(order)
+	response.AccountNumber = maskAccountNumber(order.AccountNumber)
+	return response
+}
+
+// ClaimKey 주문자 이름과 계좌번호의 블라인드 인덱스 (비로그인 주문을 계정에 연결할 때 비교)
+// 이름은 공백을 정리하고 계좌번호는 숫자만 남겨서, 입력 형식이 달라도 같은 값이 나온다.
+func ClaimKey(index *encryption.BlindIndex, name string, accountNumber string) string {
+	var digits strings.Builder
+	for _, r := range accountNumber {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+
+	return index.Compute(strings.Join(strings.Fields(name), " "), digits.String())
+}
+
+// maskAccountNumber 계좌번호의 앞 3자리와 뒤 3자리만 남기고 가림 (예: 123-***-789)
+// 구분 기호는 그대로 두고 숫자만 가리며, 6자리 이하이면 마지막 2자리만 남긴다.
+func maskAccountNumber(accountNumber string) string {
+	runes := []rune(accountNumber)
+
+	digits := 0
+	for _, r := range runes {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+
+	keepHead, keepTail := 3, 3
+	if digits <= 6 {
+		keepHead, keepTail = 0, 2
+	}
+
+	seen := 0
+	for i, r := range runes {
+		if r < '0' || r > '9' {
+			continue
+		}
+		if seen >= keepHead && seen < digits-keepTail {
+			runes[i] = '*'
+		}
+		seen++
+	}
+
+	return string(runes)
+}
+
+// findRamen 주문할 라면 메뉴 조회 (지정하지 않으면 판매 중인 첫 번째 라면)
+func (s *Service) findRamen(ctx context.Context, itemID string) (*menu.Item, error) {
+	if itemID == "" {
+		items, err := s.menuRepo.FindAll(ctx, menu.Filter{Category: menu.CategoryRamen, AvailableOnly: true})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(items) == 0 {
+			return nil, menu.ErrItemUnavailable
+		}
+
+		return &items[0], nil
+	}
+
+	return s.findOrderableItem(ctx, itemID, menu.CategoryRamen)
+}
+
+// findLineItem 주문 항목으로 주문할 메뉴 조회 (라면 또는 음료, 지정하지 않으면 판매 중인 첫 번째 라면)
+func (s *Service) findLineItem(ctx context.Context, itemID string) (*menu.Item, error) {
+	if itemID == "" {
+		return s.findRamen(ctx, itemID)
+	}
+
+	item, err := s.menuRepo.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil || !item.Available || (item.Category != menu.CategoryRamen && item.Category != menu.CategoryDrink) {
+		return nil, menu.ErrItemUnavailable
+	}
+
+	return item, nil
 }
 
-// 가격 계산 함수
-func calculateTotalPrice(order *Order) int {
-	// 기본 라면 가격
-	price := BasePrice * order.Quantity
+// buildOrderItems 요청한 주문 항목에 현재 메뉴 이름과 가격을 채움
+func (s *Service) buildOrderItems(ctx context.Context, lines []OrderItemRequest) ([]OrderItem, error) {
+	items := make([]OrderItem, 0, len(lines))
+
+	for _, line := range lines {
+		menuItem, err := s.findLineItem(ctx, line.MenuItemID)
+		if err != nil {
+			return nil, err
+		}
+
+		item := OrderItem{
+			MenuItemID: menuItem.ID,
+			ItemName:   menuItem.Name,
+			Category:   menuItem.Category,
+			UnitPrice:  menuItem.Price,
+			Quantity:   line.Quantity,
+			Toppings:   []Topping{},
+		}
+
+		// 음료는 매운맛과 토핑 없이 수량만 주문
+		if item.Category == menu.CategoryDrink {
+			if line.SpicyLevel != 0 || len(line.Toppings) > 0 {
+				return nil, ErrDrinkCustomization
+			}
+
+			item.LinePrice = item.UnitPrice * item.Quantity
+			items = append(items, item)
+			continue
+		}
+
+		item.SpicyLevel = line.SpicyLevel
+		if item.SpicyLevel == 0 {
+			item.SpicyLevel = DefaultSpicyLevel
+		}
+
+		// 토핑은 라면 한 개마다 추가
+		unitPrice := item.UnitPrice
+		for _, toppingID := range line.Toppings {
+			topping, err := s.findOrderableItem(ctx, toppingID, menu.CategoryTopping)
+			if err != nil {
+				return nil, err
+			}
+
+			item.Toppings = append(item.Toppings, Topping{
+				MenuItemID: topping.ID,
+				Name:       topping.Name,
+				Price:      topping.Price,
+			})
+			unitPrice += topping.Price
+		}
+
+		item.LinePrice = unitPrice * item.Quantity
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// orderUnits 재고를 차감할 메뉴 ID별 수량 (라면, 토핑, 추가 옵션)
+func orderUnits(order *Order) map[string]int {
+	units := make(map[string]int)
+
+	for _, item := range order.Items {
+		units[item.MenuItemID] += item.Quantity
+		for _, topping := range item.Toppings {
+			units[topping.MenuItemID] += item.Quantity
+		}
+	}
 
-	// 추가 옵션 가격
 	if order.Options.HotWaterDelivery {
-		price += HotWaterServiceFee
+		units[menu.OptionHotWaterDelivery]++
 	}
 
 	if order.Options.CookingService {
-		price += CookingServiceFee
+		units[menu.OptionCookingService]++
 	}
 
-	// 쿠폰 할인은 이 함수 밖에서 처리
+	return units
+}
 
-	return price
+// totalQuantity 주문 항목의 전체 라면 수량 (음료 제외)
+func totalQuantity(items []OrderItem) int {
+	quantity := 0
+	for _, item := range items {
+		if item.Category == menu.CategoryRamen {
+			quantity += item.Quantity
+		}
+	}
+	return quantity
 }
 
-// 주문 ID 생성 함수
-func generateOrderID() string {
-	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
-	return fmt.Sprintf("o%d", timestamp)
+// findOrderableItem 판매 중인 해당 분류의 메뉴 조회
+func (s *Service) findOrderableItem(ctx context.Context, itemID string, category string) (*menu.Item, error) {
+	item, err := s.menuRepo.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil || !item.Available || item.Category != category {
+		return nil, menu.ErrItemUnavailable
+	}
+
+	return item, nil
+}
+
+// optionFees 선택한 옵션의 현재 메뉴 가격
+func (s *Service) optionFees(ctx context.Context, options Options) ([]pricing.Option, error) {
+	var fees []pricing.Option
+
+	selected := []struct {
+		enabled bool
+		itemID  string
+	}{
+		{options.HotWaterDelivery, menu.OptionHotWaterDelivery},
+		{options.CookingService, menu.OptionCookingService},
+	}
+
+	for _, option := range selected {
+		if !option.enabled {
+			continue
+		}
+
+		item, err := s.findOrderableItem(ctx, option.itemID, menu.CategoryOption)
+		if err != nil {
+			return nil, err
+		}
+
+		fees = append(fees, pricing.Option{
+			MenuItemID: item.ID,
+			Name:       item.Name,
+			Price:      item.Price,
+		})
+	}
+
+	return fees, nil
 }
 
-// 쿠폰 ID 생성 함수
-func generateCouponID() string {
-	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
-	return fmt.Sprintf("c%d", timestamp)
+// pricingDraft 가격 계산에 넘길 주문 초안
+func pricingDraft(order *Order, fees []pricing.Option, couponData *coupon.Coupon) pricing.Draft {
+	draft := pricing.Draft{
+		Options:        fees,
+		DeliveryOption: order.DeliveryOption,
+		Coupon:         couponData,
+		At:             order.CreatedAt,
+	}
+
+	for _, item := range order.Items {
+		toppingPrice := 0
+		for _, topping := range item.Toppings {
+			toppingPrice += topping.Price
+		}
+
+		draft.Items = append(draft.Items, pricing.Item{
+			MenuItemID:   item.MenuItemID,
+			Name:         item.ItemName,
+			Category:     item.Category,
+			UnitPrice:    item.UnitPrice,
+			ToppingPrice: toppingPrice,
+			Quantity:     item.Quantity,
+		})
+	}
+
+	return draft
 }
@@ -0,0 +1,167 @@
+package order
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/myramen/be/internal/app/coupon"
+	"github.com/myramen/be/internal/pkg/idgen"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+func TestCreateOrderRedeemsCouponOnceUnderConcurrency(t *testing.T) {
+	s := newTestService(t)
+	c := s.addCoupon(t)
+
+	const attempts = 50
+
+	var (
+		wg      sync.WaitGroup
+		start   = make(chan struct{})
+		results = make([]*OrderResponse, attempts)
+		errs    = make([]error, attempts)
+	)
+
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+
+			results[i], errs[i] = s.CreateOrder(context.Background(), CreateOrderRequest{
+				Name:          "홍길동",
+				AccountNumber: "123-456-789",
+				OrderDraftRequest: OrderDraftRequest{
+					Items:          []OrderItemRequest{{MenuItemID: "shin_ramyun", Quantity: 1}},
+					DeliveryOption: "PICKUP_4F",
+					CouponID:       c.CouponID,
+				},
+			})
+		}(i)
+	}
+
+	close(start)
+	wg.Wait()
+
+	var winner *OrderResponse
+	for i := 0; i < attempts; i++ {
+		if errs[i] == nil {
+			if winner != nil {
+				t.Fatalf("coupon redeemed by two orders: %s and %s", winner.OrderID, results[i].OrderID)
+			}
+			winner = results[i]
+			continue
+		}
+
+		customErr, ok := errs[i].(errors.CustomError)
+		if !ok || customErr.Code != "COUPON_ALREADY_REDEEMED" {
+			t.Errorf("attempt %d: got error %v, want COUPON_ALREADY_REDEEMED", i, errs[i])
+		}
+	}
+
+	if winner == nil {
+		t.Fatal("no order redeemed the coupon")
+	}
+
+	if winner.AppliedCoupon == nil || winner.AppliedCoupon.CouponID != c.CouponID {
+		t.Errorf("winning order applied coupon = %+v, want %s", winner.AppliedCoupon, c.CouponID)
+	}
+
+	stored, _ := s.coupons.FindByID(context.Background(), c.CouponID)
+	if !stored.IsUsed || stored.UsedByOrderID != winner.OrderID {
+		t.Errorf("coupon used=%v usedByOrderId=%q, want used by %s", stored.IsUsed, stored.UsedByOrderID, winner.OrderID)
+	}
+
+	if got := len(s.orders.orders); got != 1 {
+		t.Errorf("saved %d orders, want 1", got)
+	}
+}
+
+func TestCreateOrderAcceptsDrinkLines(t *testing.T) {
+	s := newTestService(t)
+
+	order, err := s.CreateOrder(context.Background(), CreateOrderRequest{
+		Name:          "홍길동",
+		AccountNumber: "123-456-789",
+		OrderDraftRequest: OrderDraftRequest{
+			Items: []OrderItemRequest{
+				{MenuItemID: "shin_ramyun", Quantity: 2, Toppings: []string{"egg"}},
+				{MenuItemID: "cola", Quantity: 3},
+			},
+			DeliveryOption: "PICKUP_4F",
+		},
+	})
+	if err != nil {
+		t.Fatalf("create order: %v", err)
+	}
+
+	if order.Quantity != 2 {
+		t.Errorf("quantity = %d, want 2 ramen (drinks excluded)", order.Quantity)
+	}
+
+	if len(order.Items) != 2 {
+		t.Fatalf("items = %+v, want ramen and drink lines", order.Items)
+	}
+
+	drink := order.Items[1]
+	if drink.Category != "DRINK" || drink.SpicyLevel != 0 || len(drink.Toppings) != 0 || drink.LinePrice != 4500 {
+		t.Errorf("drink line = %+v, want DRINK without spicy level or toppings priced 4500", drink)
+	}
+
+	if order.TotalPrice != 2*(4000+500)+3*1500 {
+		t.Errorf("total price = %d, want %d", order.TotalPrice, 2*(4000+500)+3*1500)
+	}
+}
+
+func TestCreateOrderRejectsCustomizedDrink(t *testing.T) {
+	s := newTestService(t)
+
+	for name, line := range map[string]OrderItemRequest{
+		"spicy level": {MenuItemID: "cola", Quantity: 1, SpicyLevel: 2},
+		"toppings":    {MenuItemID: "cola", Quantity: 1, Toppings: []string{"egg"}},
+	} {
+		_, err := s.CreateOrder(context.Background(), CreateOrderRequest{
+			Name:          "홍길동",
+			AccountNumber: "123-456-789",
+			OrderDraftRequest: OrderDraftRequest{
+				Items:          []OrderItemRequest{line},
+				DeliveryOption: "PICKUP_4F",
+			},
+		})
+		if err != ErrDrinkCustomization {
+			t.Errorf("%s: got error %v, want %v", name, err, ErrDrinkCustomization)
+		}
+	}
+}
+
+func TestCreateOrderHidesCouponsOwnedByOthers(t *testing.T) {
+	s := newTestService(t)
+	c := s.addCoupon(t)
+
+	owned := *c
+	owned.CouponID = idgen.NewRandomGenerator().NewCouponID()
+	owned.CustomerID = 7
+	if err := s.coupons.Create(context.Background(), &owned); err != nil {
+		t.Fatalf("create coupon: %v", err)
+	}
+
+	for name, couponID := range map[string]string{
+		"owned by another customer": owned.CouponID,
+		"unknown":                   idgen.NewRandomGenerator().NewCouponID(),
+	} {
+		_, err := s.CreateOrder(context.Background(), CreateOrderRequest{
+			Name:          "홍길동",
+			AccountNumber: "123-456-789",
+			CustomerID:    8,
+			OrderDraftRequest: OrderDraftRequest{
+				Items:          []OrderItemRequest{{MenuItemID: "shin_ramyun", Quantity: 1}},
+				DeliveryOption: "PICKUP_4F",
+				CouponID:       couponID,
+			},
+		})
+		if err != coupon.ErrCouponNotFound {
+			t.Errorf("%s: got error %v, want %v", name, err, coupon.ErrCouponNotFound)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package order
+
+// pickupTransitions 픽업 주문의 상태 전이 그래프 (READY 이후 바로 픽업 완료)
+var pickupTransitions = map[string][]string{
+	StatusPending:   {StatusPaid, StatusCancelled},
+	StatusPaid:      {StatusCooking, StatusCancelled},
+	StatusCooking:   {StatusReady, StatusCancelled},
+	StatusReady:     {StatusDelivered, StatusCancelled},
+	StatusCancelled: {StatusRefunded},
+}
+
+// deliveryTransitions 배달 주문의 상태 전이 그래프
+var deliveryTransitions = map[string][]string{
+	StatusPending:    {StatusPaid, StatusCancelled},
+	StatusPaid:       {StatusCooking, StatusCancelled},
+	StatusCooking:    {StatusReady, StatusCancelled},
+	StatusReady:      {StatusDelivering, StatusCancelled},
+	StatusDelivering: {StatusDelivered, StatusCancelled},
+	StatusCancelled:  {StatusRefunded},
+}
+
+// transitionsFor 배달 방식에 맞는 상태 전이 그래프 반환
+func transitionsFor(deliveryOption string) map[string][]string {
+	switch deliveryOption {
+	case DeliveryOptionPickup4F, DeliveryOptionPickupLaundry:
+		return pickupTransitions
+	case DeliveryOptionDelivery:
+		return deliveryTransitions
+	default:
+		return nil
+	}
+}
+
+// AllowedTransitions 주문의 현재 상태에서 이동 가능한 다음 상태 목록
+func AllowedTransitions(order *Order) []string {
+	next := transitionsFor(order.DeliveryOption)[order.Status]
+	if next == nil {
+		return []string{}
+	}
+	return next
+}
+
+// CanTransition 주문을 해당 상태로 변경할 수 있는지 확인
+func CanTransition(order *Order, status string) bool {
+	for _, next := range AllowedTransitions(order) {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CanCustomerCancel 고객이 직접 취소할 수 있는 주문인지 확인 (결제 전까지만 가능)
+func CanCustomerCancel(order *Order) bool {
+	return order.Status == StatusPending
+}
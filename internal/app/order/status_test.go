@@ -0,0 +1,81 @@
+package order
+
+import (
+	"fmt"
+	"testing"
+)
+
+// allStatuses 전이 검사에 쓰는 모든 주문 상태
+var allStatuses = []string{
+	StatusPending, StatusPaid, StatusCooking, StatusReady,
+	StatusDelivering, StatusDelivered, StatusCancelled, StatusRefunded,
+}
+
+func TestStatusTransitions(t *testing.T) {
+	pickup := map[string][]string{
+		StatusPending:   {StatusPaid, StatusCancelled},
+		StatusPaid:      {StatusCooking, StatusCancelled},
+		StatusCooking:   {StatusReady, StatusCancelled},
+		StatusReady:     {StatusDelivered, StatusCancelled},
+		StatusCancelled: {StatusRefunded},
+	}
+	delivery := map[string][]string{
+		StatusPending:    {StatusPaid, StatusCancelled},
+		StatusPaid:       {StatusCooking, StatusCancelled},
+		StatusCooking:    {StatusReady, StatusCancelled},
+		StatusReady:      {StatusDelivering, StatusCancelled},
+		StatusDelivering: {StatusDelivered, StatusCancelled},
+		StatusCancelled:  {StatusRefunded},
+	}
+
+	tests := []struct {
+		deliveryOption string
+		allowed        map[string][]string
+	}{
+		{deliveryOption: DeliveryOptionPickup4F, allowed: pickup},
+		{deliveryOption: DeliveryOptionPickupLaundry, allowed: pickup},
+		{deliveryOption: DeliveryOptionDelivery, allowed: delivery},
+		{deliveryOption: "DRONE", allowed: nil},
+	}
+
+	for _, tt := range tests {
+		for _, from := range allStatuses {
+			for _, to := range allStatuses {
+				name := fmt.Sprintf("%s %s to %s", tt.deliveryOption, from, to)
+				t.Run(name, func(t *testing.T) {
+					want := false
+					for _, next := range tt.allowed[from] {
+						if next == to {
+							want = true
+						}
+					}
+
+					order := &Order{DeliveryOption: tt.deliveryOption, Status: from}
+					if got := CanTransition(order, to); got != want {
+						t.Errorf("CanTransition() = %v, want %v", got, want)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestAllowedTransitionsOfFinalStatuses(t *testing.T) {
+	for _, deliveryOption := range []string{DeliveryOptionPickup4F, DeliveryOptionPickupLaundry, DeliveryOptionDelivery, "DRONE"} {
+		for _, status := range []string{StatusDelivered, StatusRefunded} {
+			next := AllowedTransitions(&Order{DeliveryOption: deliveryOption, Status: status})
+			if next == nil || len(next) != 0 {
+				t.Errorf("AllowedTransitions(%s, %s) = %#v, want an empty list", deliveryOption, status, next)
+			}
+		}
+	}
+}
+
+func TestCanCustomerCancel(t *testing.T) {
+	for _, status := range allStatuses {
+		want := status == StatusPending
+		if got := CanCustomerCancel(&Order{DeliveryOption: DeliveryOptionDelivery, Status: status}); got != want {
+			t.Errorf("CanCustomerCancel(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package order
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/pubsub"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+// 실시간 스트림 설정
+const (
+	// StreamHeartbeatInterval 연결 유지를 위한 주석 전송 주기 (프록시 유휴 타임아웃 방지)
+	StreamHeartbeatInterval = 15 * time.Second
+	// StreamRetry 연결이 끊긴 클라이언트의 재연결 대기 시간 (밀리초)
+	StreamRetry = 3000
+)
+
+// EventOrderSnapshot 구독 시작 시 현재 주문 상태를 알리는 이벤트
+const EventOrderSnapshot = "order.snapshot"
+
+// StreamOrderEvents 단일 주문 상태 변경 스트림 핸들러 (조회 토큰 필요)
+func (h *Handler) StreamOrderEvents(c *gin.Context) {
+	orderID := c.Param("orderId")
+	if orderID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "주문 ID가 필요합니다.",
+		})
+		return
+	}
+
+	snapshot, err := h.service.GetCustomerOrderSnapshot(c, orderID, streamToken(c))
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	sub, replay := h.hub.Subscribe(func(message pubsub.Event) bool {
+		return message.Topic == orderID && message.Type == event.OrderStatusChanged
+	}, c.GetHeader("Last-Event-ID"))
+	defer sub.Close()
+
+	// 재연결이 아니면 현재 상태부터 알림
+	var initial []sse.Event
+	if len(replay) == 0 && c.GetHeader("Last-Event-ID") == "" {
+		initial = append(initial, sse.Event{Event: EventOrderSnapshot, Data: snapshot})
+	}
+
+	streamEvents(c, sub, replay, initial, func(message pubsub.Event) (interface{}, bool) {
+		orderEvent, ok := decodeOrderEvent(message)
+		if !ok {
+			return nil, false
+		}
+		return newCustomerOrderEvent(orderEvent), true
+	})
+}
+
+// StreamAllOrderEvents 전체 주문 생성/상태 변경 스트림 핸들러 (주방 화면용)
+func (h *Handler) StreamAllOrderEvents(c *gin.Context) {
+	sub, replay := h.hub.Subscribe(isOrderEvent, c.GetHeader("Last-Event-ID"))
+	defer sub.Close()
+
+	streamEvents(c, sub, replay, nil, func(message pubsub.Event) (interface{}, bool) {
+		return decodeOrderEvent(message)
+	})
+}
+
+// isOrderEvent 주문 생성/상태 변경 이벤트인지 확인
+func isOrderEvent(message pubsub.Event) bool {
+	return message.Type == event.OrderCreated || message.Type == event.OrderStatusChanged
+}
+
+// streamEvents 구독이 끝나거나 클라이언트가 연결을 끊을 때까지 이벤트 전송
+// payload가 false를 반환한 이벤트는 건너뛴다.
+func streamEvents(c *gin.Context, sub *pubsub.Subscription, replay []pubsub.Event, initial []sse.Event, payload func(pubsub.Event) (interface{}, bool)) {
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	send := func(event sse.Event) bool {
+		if err := sse.Encode(c.Writer, event); err != nil {
+			return false
+		}
+		c.Writer.Flush()
+		return true
+	}
+
+	sendMessage := func(message pubsub.Event) bool {
+		data, ok := payload(message)
+		if !ok {
+			return true
+		}
+		return send(sse.Event{Id: message.ID, Event: message.Type, Data: data})
+	}
+
+	if !send(sse.Event{Retry: StreamRetry}) {
+		return
+	}
+
+	for _, event := range initial {
+		if !send(event) {
+			return
+		}
+	}
+
+	for _, message := range replay {
+		if !sendMessage(message) {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(StreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case message, ok := <-sub.C:
+			if !ok {
+				// 서버 종료 또는 처리 지연으로 구독이 끝남 (클라이언트는 Last-Event-ID로 재연결)
+				return
+			}
+			if !sendMessage(message) {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := c.Writer.WriteString(": heartbeat\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package pricing
+
+import (
+	"time"
+)
+
+// RuleRequest 가격 규칙 생성/수정 요청 DTO (수정 시 전체 교체)
+type RuleRequest struct {
+	Name     string     `json:"name" binding:"required,max=100"`
+	Type     string     `json:"type" binding:"required,oneof=DELIVERY_FEE TIME_SURCHARGE BUY_X_GET_Y FREE_OPTION"`
+	Params   RuleParams `json:"params"`
+	Active   *bool      `json:"active,omitempty"`
+	Priority int        `json:"priority"`
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+	EndsAt   *time.Time `json:"endsAt,omitempty"`
+}
+
+// RuleListResponse 가격 규칙 목록 응답 DTO
+type RuleListResponse struct {
+	Rules []Rule `json:"rules"`
+}
+
+// ErrorResponse 에러 응답 DTO
+type ErrorResponse struct {
+	Error   string      `json:"error"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
@@ -0,0 +1,289 @@
+package pricing
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/myramen/be/internal/app/coupon"
+	"github.com/myramen/be/internal/app/menu"
+)
+
+// Draft 가격을 계산할 주문 초안
+type Draft struct {
+	Items          []Item
+	Options        []Option
+	DeliveryOption string
+	Coupon         *coupon.Coupon
+	At             time.Time
+}
+
+// Item 주문 항목 (라면 한 개 가격과 토핑 가격을 나눠서 전달)
+type Item struct {
+	MenuItemID   string
+	Name         string
+	Category     string
+	UnitPrice    int
+	ToppingPrice int
+	Quantity     int
+}
+
+// Option 선택한 추가 옵션
+type Option struct {
+	MenuItemID string
+	Name       string
+	Price      int
+}
+
+// Calculate 주문 초안에 규칙을 적용해서 가격 명세 계산
+// 추가 요금 규칙을 먼저 적용한 뒤 프로모션, 쿠폰 순으로 할인하며 결제 금액은 0원 미만으로 내려가지 않는다.
+// 쿠폰 사용 조건에 맞지 않으면 이유를 담은 쿠폰 에러를 반환한다.
+func Calculate(draft Draft, rules []Rule) (*Breakdown, error) {
+	b := &Breakdown{Lines: []Line{}}
+
+	for _, item := range draft.Items {
+		b.charge(Line{
+			Type:     LineItem,
+			Code:     item.MenuItemID,
+			Label:    item.Name,
+			Quantity: item.Quantity,
+			Amount:   (item.UnitPrice + item.ToppingPrice) * item.Quantity,
+		})
+	}
+
+	for _, option := range draft.Options {
+		b.charge(Line{
+			Type:   LineOption,
+			Code:   option.MenuItemID,
+			Label:  option.Name,
+			Amount: option.Price,
+		})
+	}
+
+	applicable := make([]Rule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.AppliesAt(draft.At) {
+			applicable = append(applicable, rule)
+		}
+	}
+	sort.SliceStable(applicable, func(i, j int) bool {
+		return applicable[i].Priority < applicable[j].Priority
+	})
+
+	for _, rule := range applicable {
+		if amount := rule.fee(draft); amount > 0 {
+			b.charge(Line{Type: LineFee, RuleID: rule.ID, Label: rule.Name, Amount: amount})
+		}
+	}
+
+	b.Subtotal = b.Total
+
+	// 규칙으로 이미 무료가 된 옵션은 쿠폰으로 다시 할인하지 않음
+	freeOptions := make(map[string]bool)
+	for _, rule := range applicable {
+		amount := rule.promotion(draft)
+		if rule.Type == RuleFreeOption && amount > 0 {
+			freeOptions[rule.Params.MenuItemID] = true
+		}
+		b.discount(Line{Type: LinePromotion, RuleID: rule.ID, Label: rule.Name}, amount)
+	}
+
+	if draft.Coupon != nil {
+		amount, err := couponDiscount(draft, draft.Coupon, b.Total, freeOptions)
+		if err != nil {
+			return nil, err
+		}
+		b.discount(Line{Type: LineCoupon, Code: draft.Coupon.CouponID, Label: "쿠폰 할인"}, amount)
+	}
+
+	return b, nil
+}
+
+// couponDiscount 쿠폰 사용 조건을 확인하고 할인 금액 계산
+// total은 쿠폰 할인 전 금액 (추가 요금과 프로모션 반영)
+func couponDiscount(draft Draft, c *coupon.Coupon, total int, freeOptions map[string]bool) (int, error) {
+	if !c.Conditions.AllowsDeliveryOption(draft.DeliveryOption) {
+		return 0, coupon.ErrCouponDeliveryOption(c.Conditions)
+	}
+
+	// 메뉴 제한이 있으면 해당 메뉴 금액(토핑 포함)만 할인 대상
+	base := total
+	if len(c.Conditions.MenuItemIDs) > 0 {
+		base = 0
+		for _, item := range draft.Items {
+			if c.Conditions.AllowsMenuItem(item.MenuItemID) {
+				base += (item.UnitPrice + item.ToppingPrice) * item.Quantity
+			}
+		}
+
+		if base == 0 {
+			return 0, coupon.ErrCouponMenuItem(c.Conditions)
+		}
+	}
+
+	var freeOption *Option
+	if c.Type == coupon.TypeFreeOption {
+		for i := range draft.Options {
+			if draft.Options[i].MenuItemID == c.FreeOption {
+				freeOption = &draft.Options[i]
+				break
+			}
+		}
+
+		if freeOption == nil {
+			return 0, coupon.ErrCouponOptionNotSelected
+		}
+	}
+
+	if total < c.Conditions.MinOrderAmount {
+		return 0, coupon.ErrCouponMinOrderAmount(c.Conditions, total)
+	}
+
+	amount := 0
+	switch c.Type {
+	case coupon.TypePercent:
+		amount = base * c.DiscountPercent / 100
+		if c.MaxDiscount > 0 && amount > c.MaxDiscount {
+			amount = c.MaxDiscount
+		}
+	case coupon.TypeFreeOption:
+		if !freeOptions[freeOption.MenuItemID] {
+			amount = freeOption.Price
+		}
+	default:
+		amount = min(c.Discount, base)
+	}
+
+	// 할인할 금액이 없는 쿠폰은 사용 처리하지 않도록 사용 불가로 응답
+	if min(amount, total) <= 0 {
+		return 0, coupon.ErrCouponNothingToDiscount
+	}
+
+	return amount, nil
+}
+
+// charge 요금 항목 추가
+func (b *Breakdown) charge(line Line) {
+	b.Lines = append(b.Lines, line)
+	b.Total += line.Amount
+}
+
+// discount 할인 항목 추가 (남은 금액보다 큰 할인은 남은 금액까지만 적용)
+func (b *Breakdown) discount(line Line, amount int) {
+	if amount > b.Total {
+		amount = b.Total
+	}
+
+	if amount <= 0 {
+		return
+	}
+
+	line.Amount = -amount
+	b.Lines = append(b.Lines, line)
+	b.Discount += amount
+	b.Total -= amount
+}
+
+// fee 추가 요금 규칙의 금액 (해당하지 않으면 0)
+func (r *Rule) fee(draft Draft) int {
+	switch r.Type {
+	case RuleDeliveryFee:
+		if draft.DeliveryOption == r.Params.DeliveryOption {
+			return r.Params.Amount
+		}
+	case RuleTimeSurcharge:
+		if inTimeWindow(draft.At, r.Params.StartTime, r.Params.EndTime) {
+			return r.Params.Amount
+		}
+	}
+
+	return 0
+}
+
+// promotion 할인 규칙의 금액 (해당하지 않으면 0)
+func (r *Rule) promotion(draft Draft) int {
+	switch r.Type {
+	case RuleBuyXGetY:
+		return buyXGetYDiscount(draft.Items, r.Params.MenuItemID, r.Params.Buy, r.Params.Free)
+	case RuleFreeOption:
+		for _, option := range draft.Options {
+			if option.MenuItemID == r.Params.MenuItemID {
+				return option.Price
+			}
+		}
+	}
+
+	return 0
+}
+
+// buyXGetYDiscount buy+free개마다 가장 싼 라면 free개 가격 (토핑 제외, 메뉴를 지정하지 않으면 음료는 제외)
+func buyXGetYDiscount(items []Item, menuItemID string, buy int, free int) int {
+	if buy <= 0 || free <= 0 {
+		return 0
+	}
+
+	// 수량만큼 펼치지 않고 항목별 (가격, 수량)을 싼 순서로 정렬해서 계산
+	var lines []Item
+	count := 0
+	for _, item := range items {
+		if menuItemID != "" && item.MenuItemID != menuItemID {
+			continue
+		}
+		if menuItemID == "" && item.Category != menu.CategoryRamen {
+			continue
+		}
+		if item.Quantity <= 0 {
+			continue
+		}
+		lines = append(lines, item)
+		count += item.Quantity
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].UnitPrice < lines[j].UnitPrice
+	})
+
+	remaining := count / (buy + free) * free
+	discount := 0
+	for _, line := range lines {
+		if remaining == 0 {
+			break
+		}
+
+		units := min(line.Quantity, remaining)
+		discount += line.UnitPrice * units
+		remaining -= units
+	}
+
+	return discount
+}
+
+// inTimeWindow 서버 시간대 기준으로 at이 [start, end) 시간대에 속하는지 여부 (자정을 넘기는 시간대 포함)
+func inTimeWindow(at time.Time, start string, end string) bool {
+	startMinute, err := parseClock(start)
+	if err != nil {
+		return false
+	}
+
+	endMinute, err := parseClock(end)
+	if err != nil {
+		return false
+	}
+
+	local := at.In(time.Local)
+	minute := local.Hour()*60 + local.Minute()
+
+	if startMinute <= endMinute {
+		return minute >= startMinute && minute < endMinute
+	}
+	return minute >= startMinute || minute < endMinute
+}
+
+// parseClock "HH:MM"을 자정 이후 분으로 변환
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid clock %q: %w", value, err)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
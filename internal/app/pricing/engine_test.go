@@ -0,0 +1,106 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myramen/be/internal/app/coupon"
+	"github.com/myramen/be/internal/app/menu"
+)
+
+func TestBuyXGetYDiscount(t *testing.T) {
+	shin := Item{MenuItemID: "shin_ramyun", Category: menu.CategoryRamen, UnitPrice: 4000, ToppingPrice: 500}
+	jin := Item{MenuItemID: "jin_ramen", Category: menu.CategoryRamen, UnitPrice: 3500}
+	cola := Item{MenuItemID: "cola", Category: menu.CategoryDrink, UnitPrice: 1500}
+
+	withQuantity := func(item Item, quantity int) Item {
+		item.Quantity = quantity
+		return item
+	}
+
+	tests := []struct {
+		name       string
+		items      []Item
+		menuItemID string
+		buy, free  int
+		want       int
+	}{
+		{
+			name:  "not enough units",
+			items: []Item{withQuantity(shin, 4)},
+			buy:   4, free: 1,
+			want: 0,
+		},
+		{
+			name:  "cheapest units are free, toppings excluded",
+			items: []Item{withQuantity(shin, 3), withQuantity(jin, 2)},
+			buy:   4, free: 1,
+			want: 3500,
+		},
+		{
+			name:  "free units span lines",
+			items: []Item{withQuantity(shin, 7), withQuantity(jin, 1)},
+			buy:   2, free: 2,
+			want: 3500 + 3*4000,
+		},
+		{
+			name:  "drinks are not counted without a menu item",
+			items: []Item{withQuantity(shin, 2), withQuantity(cola, 5)},
+			buy:   2, free: 1,
+			want: 0,
+		},
+		{
+			name:       "only the given menu item",
+			items:      []Item{withQuantity(shin, 3), withQuantity(jin, 3)},
+			menuItemID: "shin_ramyun",
+			buy:        2, free: 1,
+			want: 4000,
+		},
+		{
+			name:  "large quantities are computed without expanding units",
+			items: []Item{withQuantity(shin, 1_000_000), withQuantity(jin, 1_000_000)},
+			buy:   1, free: 1,
+			want: 1_000_000 * 3500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buyXGetYDiscount(tt.items, tt.menuItemID, tt.buy, tt.free); got != tt.want {
+				t.Errorf("discount = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateRejectsCouponWithNothingToDiscount(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	draft := Draft{
+		Items:          []Item{{MenuItemID: "shin_ramyun", Name: "신라면", Category: menu.CategoryRamen, UnitPrice: 4000, Quantity: 1}},
+		Options:        []Option{{MenuItemID: "cooking_service", Name: "조리 서비스", Price: 500}},
+		DeliveryOption: "PICKUP_4F",
+		Coupon:         &coupon.Coupon{CouponID: "free-cooking", Type: coupon.TypeFreeOption, FreeOption: "cooking_service"},
+		At:             at,
+	}
+	freeCooking := Rule{ID: 1, Name: "조리 서비스 무료", Type: RuleFreeOption, Params: RuleParams{MenuItemID: "cooking_service"}, Active: true}
+
+	// 규칙 없이 쓰면 옵션 요금만큼 할인
+	breakdown, err := Calculate(draft, nil)
+	if err != nil {
+		t.Fatalf("Calculate() without rules error = %v", err)
+	}
+	if breakdown.Total != 4000 {
+		t.Errorf("total without rules = %d, want 4000", breakdown.Total)
+	}
+
+	// 규칙으로 이미 무료인 옵션의 쿠폰은 사용할 수 없음
+	if _, err := Calculate(draft, []Rule{freeCooking}); err != coupon.ErrCouponNothingToDiscount {
+		t.Errorf("Calculate() with free option rule error = %v, want %v", err, coupon.ErrCouponNothingToDiscount)
+	}
+
+	// 할인할 금액이 0원인 정액 쿠폰도 사용할 수 없음
+	draft.Coupon = &coupon.Coupon{CouponID: "zero", Type: coupon.TypeFixed, Discount: 0}
+	if _, err := Calculate(draft, nil); err != coupon.ErrCouponNothingToDiscount {
+		t.Errorf("Calculate() with zero fixed coupon error = %v, want %v", err, coupon.ErrCouponNothingToDiscount)
+	}
+}
@@ -0,0 +1,15 @@
+package pricing
+
+import (
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 가격 규칙 관련 에러
+var (
+	ErrRuleNotFound = errors.NotFound("NOT_FOUND", "해당 가격 규칙을 찾을 수 없습니다.")
+)
+
+// invalidRule 규칙 설정 오류
+func invalidRule(message string) error {
+	return errors.BadRequest("INVALID_PRICING_RULE", message)
+}
@@ -0,0 +1,134 @@
+package pricing
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 가격 규칙 핸들러
+type Handler struct {
+	service *Service
+}
+
+// NewHandler 가격 규칙 핸들러 생성
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes 라우트 등록
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	admin := r.Group("/admin")
+	{
+		admin.Use(middleware.AdminAuth())
+		admin.GET("/pricing-rules", h.GetRules)
+		admin.POST("/pricing-rules", h.CreateRule)
+		admin.GET("/pricing-rules/:ruleId", h.GetRule)
+		admin.PUT("/pricing-rules/:ruleId", h.UpdateRule)
+		admin.DELETE("/pricing-rules/:ruleId", h.DeleteRule)
+	}
+}
+
+// GetRules 가격 규칙 목록 조회 핸들러
+func (h *Handler) GetRules(c *gin.Context) {
+	result, err := h.service.GetRules(c)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CreateRule 가격 규칙 생성 핸들러
+func (h *Handler) CreateRule(c *gin.Context) {
+	var req RuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "가격 규칙 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.CreateRule(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetRule 가격 규칙 조회 핸들러
+func (h *Handler) GetRule(c *gin.Context) {
+	id, ok := ruleIDParam(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.GetRule(c, id)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// UpdateRule 가격 규칙 수정 핸들러
+func (h *Handler) UpdateRule(c *gin.Context) {
+	id, ok := ruleIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req RuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "가격 규칙 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.UpdateRule(c, id, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteRule 가격 규칙 삭제 핸들러
+func (h *Handler) DeleteRule(c *gin.Context) {
+	id, ok := ruleIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteRule(c, id); err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ruleIDParam 경로의 규칙 ID 파싱 (숫자가 아니면 404 응답)
+func ruleIDParam(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("ruleId"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "NOT_FOUND",
+			Message: "해당 가격 규칙을 찾을 수 없습니다.",
+		})
+		return 0, false
+	}
+	return id, true
+}
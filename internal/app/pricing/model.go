@@ -0,0 +1,98 @@
+package pricing
+
+import (
+	"time"
+)
+
+// Rule 가격 규칙 (요금 추가 또는 프로모션 할인)
+type Rule struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Type      string     `json:"type"`
+	Params    RuleParams `json:"params"`
+	Active    bool       `json:"active"`
+	Priority  int        `json:"priority"`
+	StartsAt  *time.Time `json:"startsAt,omitempty"`
+	EndsAt    *time.Time `json:"endsAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// RuleParams 규칙 유형별 설정 (유형에 필요한 항목만 사용)
+type RuleParams struct {
+	// Amount 추가 요금 (DELIVERY_FEE, TIME_SURCHARGE)
+	Amount int `json:"amount,omitempty" binding:"min=0"`
+	// DeliveryOption 배달비를 받을 배달 방식 (DELIVERY_FEE)
+	DeliveryOption string `json:"deliveryOption,omitempty" binding:"omitempty,oneof=PICKUP_4F PICKUP_LAUNDRY DELIVERY"`
+	// StartTime, EndTime 할증 시간대 "HH:MM" (TIME_SURCHARGE, 자정을 넘길 수 있음)
+	StartTime string `json:"startTime,omitempty"`
+	EndTime   string `json:"endTime,omitempty"`
+	// MenuItemID 대상 라면 메뉴 (BUY_X_GET_Y, 비어 있으면 모든 라면) 또는 무료 옵션 메뉴 (FREE_OPTION)
+	MenuItemID string `json:"menuItemId,omitempty"`
+	// Buy, Free Buy개를 사면 Free개 무료 (BUY_X_GET_Y)
+	Buy  int `json:"buy,omitempty" binding:"min=0"`
+	Free int `json:"free,omitempty" binding:"min=0"`
+}
+
+// 규칙 유형
+const (
+	RuleDeliveryFee   = "DELIVERY_FEE"
+	RuleTimeSurcharge = "TIME_SURCHARGE"
+	RuleBuyXGetY      = "BUY_X_GET_Y"
+	RuleFreeOption    = "FREE_OPTION"
+)
+
+// AppliesAt 규칙이 해당 시각에 적용되는지 여부
+func (r *Rule) AppliesAt(at time.Time) bool {
+	if !r.Active {
+		return false
+	}
+
+	if r.StartsAt != nil && at.Before(*r.StartsAt) {
+		return false
+	}
+
+	if r.EndsAt != nil && !at.Before(*r.EndsAt) {
+		return false
+	}
+
+	return true
+}
+
+// Breakdown 주문 가격 명세
+type Breakdown struct {
+	Lines    []Line `json:"lines"`
+	Subtotal int    `json:"subtotal"` // 할인 전 금액 (주문 항목 + 옵션 + 추가 요금)
+	Discount int    `json:"discount"` // 실제로 적용된 할인 합계
+	Total    int    `json:"total"`    // 결제 금액 (0원 미만으로 내려가지 않음)
+}
+
+// CouponDiscount 쿠폰으로 실제 할인된 금액
+func (b *Breakdown) CouponDiscount() int {
+	discount := 0
+	for _, line := range b.Lines {
+		if line.Type == LineCoupon {
+			discount -= line.Amount
+		}
+	}
+	return discount
+}
+
+// Line 가격 명세 한 줄 (할인은 음수)
+type Line struct {
+	Type     string `json:"type"`
+	Code     string `json:"code,omitempty"`
+	RuleID   int64  `json:"ruleId,omitempty"`
+	Label    string `json:"label"`
+	Quantity int    `json:"quantity,omitempty"`
+	Amount   int    `json:"amount"`
+}
+
+// 가격 명세 항목 유형
+const (
+	LineItem      = "ITEM"
+	LineOption    = "OPTION"
+	LineFee       = "FEE"
+	LinePromotion = "PROMOTION"
+	LineCoupon    = "COUPON"
+)
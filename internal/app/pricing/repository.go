@@ -0,0 +1,23 @@
+package pricing
+
+import (
+	"context"
+)
+
+// Repository 가격 규칙 리포지토리 인터페이스
+type Repository interface {
+	// Create 가격 규칙 생성
+	Create(ctx context.Context, rule *Rule) error
+
+	// FindByID ID로 가격 규칙 조회 (없으면 nil)
+	FindByID(ctx context.Context, id int64) (*Rule, error)
+
+	// FindAll 가격 규칙을 우선순위 순으로 조회 (activeOnly이면 활성화된 규칙만)
+	FindAll(ctx context.Context, activeOnly bool) ([]Rule, error)
+
+	// Update 가격 규칙 수정
+	Update(ctx context.Context, rule *Rule) error
+
+	// Delete 가격 규칙 삭제
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,154 @@
+package pricing
+
+import (
+	"context"
+	"time"
+)
+
+// Service 가격 서비스
+type Service struct {
+	repo Repository
+}
+
+// NewService 가격 서비스 생성
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Quote 현재 활성화된 규칙으로 주문 초안의 가격 명세 계산
+func (s *Service) Quote(ctx context.Context, draft Draft) (*Breakdown, error) {
+	rules, err := s.repo.FindAll(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return Calculate(draft, rules)
+}
+
+// GetRules 전체 가격 규칙 조회
+func (s *Service) GetRules(ctx context.Context) (*RuleListResponse, error) {
+	rules, err := s.repo.FindAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if rules == nil {
+		rules = []Rule{}
+	}
+
+	return &RuleListResponse{Rules: rules}, nil
+}
+
+// GetRule 가격 규칙 조회
+func (s *Service) GetRule(ctx context.Context, id int64) (*Rule, error) {
+	return s.findRule(ctx, id)
+}
+
+// CreateRule 가격 규칙 생성
+func (s *Service) CreateRule(ctx context.Context, req RuleRequest) (*Rule, error) {
+	rule := &Rule{
+		Active:    true,
+		CreatedAt: time.Now(),
+	}
+
+	if err := applyRuleRequest(rule, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// UpdateRule 가격 규칙 수정 (이미 접수된 주문의 가격은 바뀌지 않음)
+func (s *Service) UpdateRule(ctx context.Context, id int64, req RuleRequest) (*Rule, error) {
+	rule, err := s.findRule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyRuleRequest(rule, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteRule 가격 규칙 삭제
+func (s *Service) DeleteRule(ctx context.Context, id int64) error {
+	if _, err := s.findRule(ctx, id); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *Service) findRule(ctx context.Context, id int64) (*Rule, error) {
+	rule, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule == nil {
+		return nil, ErrRuleNotFound
+	}
+
+	return rule, nil
+}
+
+// applyRuleRequest 요청 내용을 검증해서 규칙에 반영
+func applyRuleRequest(rule *Rule, req RuleRequest) error {
+	if err := validateParams(req.Type, req.Params); err != nil {
+		return err
+	}
+
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		return invalidRule("종료 일시는 시작 일시보다 뒤여야 합니다.")
+	}
+
+	rule.Name = req.Name
+	rule.Type = req.Type
+	rule.Params = req.Params
+	rule.Priority = req.Priority
+	rule.StartsAt = req.StartsAt
+	rule.EndsAt = req.EndsAt
+	rule.UpdatedAt = time.Now()
+
+	if req.Active != nil {
+		rule.Active = *req.Active
+	}
+
+	return nil
+}
+
+// validateParams 규칙 유형에 필요한 설정 확인
+func validateParams(ruleType string, params RuleParams) error {
+	switch ruleType {
+	case RuleDeliveryFee:
+		if params.DeliveryOption == "" || params.Amount <= 0 {
+			return invalidRule("배달비 규칙에는 deliveryOption과 amount(1 이상)가 필요합니다.")
+		}
+	case RuleTimeSurcharge:
+		start, startErr := parseClock(params.StartTime)
+		end, endErr := parseClock(params.EndTime)
+		if startErr != nil || endErr != nil || start == end || params.Amount <= 0 {
+			return invalidRule("시간대 할증 규칙에는 서로 다른 startTime, endTime(HH:MM)과 amount(1 이상)가 필요합니다.")
+		}
+	case RuleBuyXGetY:
+		if params.Buy <= 0 || params.Free <= 0 {
+			return invalidRule("N+M 규칙에는 buy와 free(각각 1 이상)가 필요합니다.")
+		}
+	case RuleFreeOption:
+		if params.MenuItemID == "" {
+			return invalidRule("무료 옵션 규칙에는 menuItemId가 필요합니다.")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// 전달 설정
+const (
+	// dispatchBatchSize 한 번에 가져오는 전달 수
+	dispatchBatchSize = 20
+	// deliveryLease 전달 중인 항목을 다른 인스턴스가 가져가지 않도록 미루는 시간
+	deliveryLease = 2 * time.Minute
+	// retryBaseDelay 첫 재시도 대기 시간 (이후 2배씩 증가)
+	retryBaseDelay = 30 * time.Second
+	// retryMaxDelay 재시도 대기 시간 상한
+	retryMaxDelay = time.Hour
+	// maxResponseBodyLog 시도 기록에 남기는 응답 본문 최대 길이
+	maxResponseBodyLog = 1024
+	// maxErrorLog 전달 기록에 남기는 오류 메시지 최대 길이
+	maxErrorLog = 255
+)
+
+// Sign 수신 서버 검증용 서명 (HMAC-SHA256(secret, "<timestamp>.<body>")의 16진수)
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignatureHeader 서명 헤더 값 ("t=<unix초>,v1=<서명>")
+func SignatureHeader(secret string, timestamp int64, body []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, Sign(secret, timestamp, body))
+}
+
+// Dispatcher 전달 대기열을 주기적으로 확인해 웹훅을 전송
+type Dispatcher struct {
+	service     *Service
+	client      *http.Client
+	interval    time.Duration
+	maxAttempts int
+}
+
+// NewDispatcher 웹훅 전송기 생성
+func NewDispatcher(service *Service, client *http.Client, interval time.Duration, maxAttempts int) *Dispatcher {
+	return &Dispatcher{
+		service:     service,
+		client:      client,
+		interval:    interval,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Run ctx가 취소될 때까지 전달 대기열 처리 (진행 중인 묶음은 끝까지 전송한 뒤 반환)
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain 대기열이 빌 때까지 묶음 단위로 전송
+func (d *Dispatcher) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		deliveries, err := d.service.claimDueDeliveries(ctx, dispatchBatchSize, deliveryLease)
+		if err != nil {
+			log.Printf("webhook: failed to claim deliveries: %v", err)
+			return
+		}
+
+		for i := range deliveries {
+			d.deliver(context.WithoutCancel(ctx), &deliveries[i])
+		}
+
+		if len(deliveries) < dispatchBatchSize {
+			return
+		}
+	}
+}
+
+// deliver 전달 하나를 전송하고 결과 기록
+func (d *Dispatcher) deliver(ctx context.Context, delivery *Delivery) {
+	attempt := &Attempt{
+		DeliveryID:  delivery.ID,
+		AttemptedAt: time.Now(),
+	}
+
+	subscription, err := d.service.repo.FindSubscriptionByID(ctx, delivery.SubscriptionID)
+	switch {
+	case err != nil:
+		log.Printf("webhook: failed to load subscription %d: %v", delivery.SubscriptionID, err)
+		return
+	case subscription == nil || !subscription.Active:
+		// 비활성화된 구독은 재시도하지 않음 (다시 활성화한 뒤 재전송 가능)
+		attempt.Error = "subscription is not active"
+		delivery.Attempts++
+		delivery.Status = DeliveryFailed
+	default:
+		d.send(ctx, subscription, delivery, attempt)
+	}
+
+	delivery.LastStatusCode = attempt.StatusCode
+	delivery.LastError = attempt.Error
+	delivery.UpdatedAt = time.Now()
+
+	if err := d.service.recordAttempt(ctx, delivery, attempt); err != nil {
+		log.Printf("webhook: failed to record delivery %d: %v", delivery.ID, err)
+	}
+}
+
+// send HTTP 요청을 보내고 성공/재시도/실패 판정
+func (d *Dispatcher) send(ctx context.Context, subscription *Subscription, delivery *Delivery, attempt *Attempt) {
+	delivery.Attempts++
+
+	statusCode, body, err := d.post(ctx, subscription, delivery)
+	attempt.DurationMs = time.Since(attempt.AttemptedAt).Milliseconds()
+	attempt.StatusCode = statusCode
+	attempt.ResponseBody = body
+
+	if err == nil && statusCode >= 200 && statusCode < 300 {
+		now := time.Now()
+		delivery.Status = DeliverySucceeded
+		delivery.DeliveredAt = &now
+		return
+	}
+
+	if err != nil {
+		attempt.Error = truncate(err.Error(), maxErrorLog)
+	} else {
+		attempt.Error = fmt.Sprintf("unexpected status code %d", statusCode)
+	}
+
+	if delivery.Attempts >= d.maxAttempts {
+		delivery.Status = DeliveryFailed
+		return
+	}
+
+	delivery.Status = DeliveryPending
+	delivery.NextAttemptAt = time.Now().Add(retryDelay(delivery.Attempts))
+}
+
+func (d *Dispatcher) post(ctx context.Context, subscription *Subscription, delivery *Delivery) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "MyRamen-Webhook/1.0")
+	req.Header.Set(HeaderEventID, delivery.EventID)
+	req.Header.Set(HeaderEventType, delivery.EventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderSignature, SignatureHeader(subscription.Secret, time.Now().Unix(), delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyLog))
+	return resp.StatusCode, string(bytes.ToValidUTF8(body, nil)), nil
+}
+
+// retryDelay n번째 실패 후 다음 시도까지 대기 시간 (30초, 1분, 2분, ... 최대 1시간)
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return delay
+}
+
+func truncate(s string, max int) string {
+	if runes := []rune(s); len(runes) > max {
+		return string(runes[:max])
+	}
+	return s
+}
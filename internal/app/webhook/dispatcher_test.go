@@ -0,0 +1,208 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeTx 트랜잭션 없이 fn을 그대로 실행
+type fakeTx struct{}
+
+func (fakeTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeRepo 구독 하나와 전달 대기열을 메모리에 보관하는 저장소
+// 인터페이스를 임베드해서 전송기가 쓰는 메서드만 구현한다.
+type fakeRepo struct {
+	Repository
+
+	subscription Subscription
+	deliveries   map[int64]*Delivery
+	attempts     []Attempt
+}
+
+func (r *fakeRepo) FindSubscriptionByID(ctx context.Context, id int64) (*Subscription, error) {
+	if id != r.subscription.ID {
+		return nil, nil
+	}
+
+	subscription := r.subscription
+	return &subscription, nil
+}
+
+func (r *fakeRepo) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]Delivery, error) {
+	var due []Delivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == DeliveryPending && !delivery.NextAttemptAt.After(now) && len(due) < limit {
+			due = append(due, *delivery)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeRepo) LeaseDeliveries(ctx context.Context, ids []int64, until time.Time) error {
+	for _, id := range ids {
+		r.deliveries[id].NextAttemptAt = until
+	}
+	return nil
+}
+
+func (r *fakeRepo) UpdateDeliveryResult(ctx context.Context, delivery *Delivery) error {
+	saved := *delivery
+	r.deliveries[delivery.ID] = &saved
+	return nil
+}
+
+func (r *fakeRepo) AddAttempt(ctx context.Context, attempt *Attempt) error {
+	r.attempts = append(r.attempts, *attempt)
+	return nil
+}
+
+// receivedRequest 수신 서버가 받은 요청
+type receivedRequest struct {
+	signature  string
+	deliveryID string
+	body       []byte
+}
+
+func TestDispatcherRetriesFailedDeliveryUntilSuccess(t *testing.T) {
+	const secret = "whsec_test_secret"
+
+	var (
+		mu       sync.Mutex
+		received []receivedRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		received = append(received, receivedRequest{
+			signature:  r.Header.Get(HeaderSignature),
+			deliveryID: r.Header.Get(HeaderDelivery),
+			body:       body,
+		})
+		count := len(received)
+		mu.Unlock()
+
+		// 첫 요청만 실패
+		if count == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	payload := []byte(`{"id":"lxk2m1a0-42","type":"order.created","data":{}}`)
+	repo := &fakeRepo{
+		subscription: Subscription{ID: 1, URL: server.URL, Secret: secret, Active: true},
+		deliveries: map[int64]*Delivery{
+			7: {ID: 7, SubscriptionID: 1, EventID: "lxk2m1a0-42", EventType: "order.created", Payload: payload, Status: DeliveryPending},
+		},
+	}
+	dispatcher := NewDispatcher(NewService(repo, fakeTx{}), server.Client(), time.Second, 8)
+	ctx := context.Background()
+
+	before := time.Now()
+	dispatcher.drain(ctx)
+	after := time.Now()
+
+	delivery := repo.deliveries[7]
+	if delivery.Status != DeliveryPending || delivery.Attempts != 1 || delivery.LastStatusCode != http.StatusInternalServerError {
+		t.Fatalf("after failed attempt delivery = %+v, want PENDING with 1 attempt and status 500", delivery)
+	}
+
+	// 두 번째 시도는 첫 실패 후 retryDelay(1) 뒤로 예약되어야 함
+	delay := retryDelay(1)
+	if delivery.NextAttemptAt.Before(before.Add(delay)) || delivery.NextAttemptAt.After(after.Add(delay)) {
+		t.Errorf("next attempt at %s, want %s after the failed attempt", delivery.NextAttemptAt, delay)
+	}
+
+	// 예약 시각 전에는 다시 보내지 않음
+	dispatcher.drain(ctx)
+	mu.Lock()
+	sent := len(received)
+	mu.Unlock()
+	if sent != 1 {
+		t.Fatalf("sent %d requests before the retry was due, want 1", sent)
+	}
+
+	// 대기 시간이 지난 것으로 만들고 다시 전송
+	delivery.NextAttemptAt = time.Now().Add(-time.Second)
+	dispatcher.drain(ctx)
+
+	delivery = repo.deliveries[7]
+	if delivery.Status != DeliverySucceeded || delivery.Attempts != 2 || delivery.DeliveredAt == nil {
+		t.Fatalf("after retry delivery = %+v, want SUCCEEDED after 2 attempts", delivery)
+	}
+
+	if len(repo.attempts) != 2 || repo.attempts[0].StatusCode != http.StatusInternalServerError || repo.attempts[1].StatusCode != http.StatusNoContent {
+		t.Errorf("attempts = %+v, want a 500 then a 204", repo.attempts)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(received) != 2 {
+		t.Fatalf("server received %d requests, want 2", len(received))
+	}
+
+	for i, req := range received {
+		if req.deliveryID != "7" {
+			t.Errorf("request %d delivery header = %q, want 7", i, req.deliveryID)
+		}
+
+		if string(req.body) != string(payload) {
+			t.Errorf("request %d body = %s, want %s", i, req.body, payload)
+		}
+
+		timestamp, signature, ok := parseSignatureHeader(req.signature)
+		if !ok {
+			t.Fatalf("request %d signature header %q is not t=..,v1=..", i, req.signature)
+		}
+
+		if want := Sign(secret, timestamp, req.body); signature != want {
+			t.Errorf("request %d signature = %s, want %s", i, signature, want)
+		}
+
+		if age := time.Since(time.Unix(timestamp, 0)); age < -time.Minute || age > time.Minute {
+			t.Errorf("request %d signature timestamp %d is not current", i, timestamp)
+		}
+	}
+}
+
+// parseSignatureHeader 수신 서버처럼 "t=<unix초>,v1=<서명>" 헤더 파싱
+func parseSignatureHeader(header string) (int64, string, bool) {
+	var (
+		timestamp int64
+		signature string
+	)
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return 0, "", false
+		}
+
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return 0, "", false
+			}
+			timestamp = parsed
+		case "v1":
+			signature = value
+		}
+	}
+
+	return timestamp, signature, timestamp != 0 && signature != ""
+}
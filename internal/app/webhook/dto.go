@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CreateSubscriptionRequest 웹훅 구독 생성 요청 DTO
+type CreateSubscriptionRequest struct {
+	URL         string   `json:"url" binding:"required"`
+	Secret      string   `json:"secret,omitempty" binding:"omitempty,min=16,max=255"`
+	EventTypes  []string `json:"eventTypes" binding:"required,min=1"`
+	Description string   `json:"description,omitempty" binding:"max=255"`
+}
+
+// UpdateSubscriptionRequest 웹훅 구독 수정 요청 DTO (전달한 항목만 변경)
+type UpdateSubscriptionRequest struct {
+	URL         *string  `json:"url,omitempty"`
+	EventTypes  []string `json:"eventTypes,omitempty"`
+	Description *string  `json:"description,omitempty" binding:"omitempty,max=255"`
+	Active      *bool    `json:"active,omitempty"`
+}
+
+// SubscriptionResponse 웹훅 구독 응답 DTO
+// 서명 키 원문은 생성 응답에서만 반환하고 이후에는 끝 4자리만 보여준다.
+type SubscriptionResponse struct {
+	ID          int64     `json:"id"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"secret,omitempty"`
+	SecretHint  string    `json:"secretHint"`
+	EventTypes  []string  `json:"eventTypes"`
+	Description string    `json:"description,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// SubscriptionListResponse 웹훅 구독 목록 응답 DTO
+type SubscriptionListResponse struct {
+	Subscriptions []SubscriptionResponse `json:"subscriptions"`
+}
+
+// ListDeliveriesRequest 전달 기록 조회 요청 DTO
+type ListDeliveriesRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=PENDING SUCCEEDED FAILED"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+// DeliveryResponse 전달 기록 응답 DTO
+type DeliveryResponse struct {
+	Delivery
+	Payload    json.RawMessage `json:"payload"`
+	AttemptLog []Attempt       `json:"attemptLog,omitempty"`
+}
+
+// DeliveryListResponse 전달 기록 목록 응답 DTO
+type DeliveryListResponse struct {
+	Deliveries []DeliveryResponse `json:"deliveries"`
+}
+
+// ErrorResponse 에러 응답 DTO
+type ErrorResponse struct {
+	Error   string      `json:"error"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
@@ -0,0 +1,13 @@
+package webhook
+
+import (
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 웹훅 관련 에러
+var (
+	ErrSubscriptionNotFound = errors.NotFound("NOT_FOUND", "해당 웹훅 구독을 찾을 수 없습니다.")
+	ErrDeliveryNotFound     = errors.NotFound("NOT_FOUND", "해당 웹훅 전달 기록을 찾을 수 없습니다.")
+	ErrInvalidURL           = errors.BadRequest("INVALID_WEBHOOK_URL", "웹훅 URL은 http 또는 https 주소여야 합니다.")
+	ErrInvalidEventType     = errors.BadRequest("INVALID_EVENT_TYPE", "지원하지 않는 이벤트 유형입니다.")
+)
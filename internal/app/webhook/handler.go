@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 웹훅 핸들러
+type Handler struct {
+	service *Service
+}
+
+// NewHandler 웹훅 핸들러 생성
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes 라우트 등록
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	admin := r.Group("/admin")
+	{
+		admin.Use(middleware.AdminAuth())
+		admin.POST("/webhooks", h.CreateSubscription)
+		admin.GET("/webhooks", h.GetSubscriptions)
+		admin.GET("/webhooks/:webhookId", h.GetSubscription)
+		admin.PATCH("/webhooks/:webhookId", h.UpdateSubscription)
+		admin.DELETE("/webhooks/:webhookId", h.DeleteSubscription)
+		admin.GET("/webhooks/:webhookId/deliveries", h.GetDeliveries)
+		admin.GET("/webhook-deliveries/:deliveryId", h.GetDelivery)
+		admin.POST("/webhook-deliveries/:deliveryId/redeliver", h.Redeliver)
+	}
+}
+
+// CreateSubscription 웹훅 구독 생성 핸들러
+func (h *Handler) CreateSubscription(c *gin.Context) {
+	var req CreateSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "웹훅 구독 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.CreateSubscription(c, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetSubscriptions 웹훅 구독 목록 조회 핸들러
+func (h *Handler) GetSubscriptions(c *gin.Context) {
+	result, err := h.service.GetSubscriptions(c)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetSubscription 웹훅 구독 조회 핸들러
+func (h *Handler) GetSubscription(c *gin.Context) {
+	id, ok := idParam(c, "webhookId")
+	if !ok {
+		return
+	}
+
+	result, err := h.service.GetSubscription(c, id)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// UpdateSubscription 웹훅 구독 수정 핸들러 (URL, 이벤트 유형, 설명, 활성 여부)
+func (h *Handler) UpdateSubscription(c *gin.Context) {
+	id, ok := idParam(c, "webhookId")
+	if !ok {
+		return
+	}
+
+	var req UpdateSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "웹훅 구독 정보가 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.UpdateSubscription(c, id, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteSubscription 웹훅 구독 삭제 핸들러
+func (h *Handler) DeleteSubscription(c *gin.Context) {
+	id, ok := idParam(c, "webhookId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteSubscription(c, id); err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetDeliveries 웹훅 전달 기록 조회 핸들러
+func (h *Handler) GetDeliveries(c *gin.Context) {
+	id, ok := idParam(c, "webhookId")
+	if !ok {
+		return
+	}
+
+	var req ListDeliveriesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "전달 기록 조회 조건이 유효하지 않습니다.",
+		})
+		return
+	}
+
+	result, err := h.service.GetDeliveries(c, id, req)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetDelivery 웹훅 전달 상세 조회 핸들러 (시도 기록 포함)
+func (h *Handler) GetDelivery(c *gin.Context) {
+	id, ok := idParam(c, "deliveryId")
+	if !ok {
+		return
+	}
+
+	result, err := h.service.GetDelivery(c, id)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Redeliver 웹훅 수동 재전송 핸들러
+func (h *Handler) Redeliver(c *gin.Context) {
+	id, ok := idParam(c, "deliveryId")
+	if !ok {
+		return
+	}
+
+	result, err := h.service.Redeliver(c, id)
+	if err != nil {
+		errors.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, result)
+}
+
+// idParam 경로의 숫자 ID 파싱 (잘못된 형식이면 404 응답 후 false)
+func idParam(c *gin.Context, name string) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param(name), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "NOT_FOUND",
+			Message: "해당 리소스를 찾을 수 없습니다.",
+		})
+		return 0, false
+	}
+	return id, true
+}
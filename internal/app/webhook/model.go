@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/event"
+)
+
+// Subscription 웹훅 구독
+type Subscription struct {
+	ID          int64     `json:"id"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"-"`
+	EventTypes  []string  `json:"eventTypes"`
+	Description string    `json:"description,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// Delivery 구독 하나로 보낼 이벤트 하나 (전달 대기열 겸 전달 기록)
+type Delivery struct {
+	ID             int64      `json:"id"`
+	SubscriptionID int64      `json:"subscriptionId"`
+	EventID        string     `json:"eventId"`
+	EventType      string     `json:"eventType"`
+	Payload        []byte     `json:"-"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt"`
+	LastStatusCode int        `json:"lastStatusCode,omitempty"`
+	LastError      string     `json:"lastError,omitempty"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+// Attempt 전달 시도 기록
+type Attempt struct {
+	ID           int64     `json:"id"`
+	DeliveryID   int64     `json:"deliveryId"`
+	StatusCode   int       `json:"statusCode,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	ResponseBody string    `json:"responseBody,omitempty"`
+	DurationMs   int64     `json:"durationMs"`
+	AttemptedAt  time.Time `json:"attemptedAt"`
+}
+
+// Event 수신 서버로 보내는 본문
+type Event struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// EventTypes 구독할 수 있는 이벤트 유형 목록
+var EventTypes = event.Types
+
+// Delivery 상태
+const (
+	DeliveryPending   = "PENDING"
+	DeliverySucceeded = "SUCCEEDED"
+	DeliveryFailed    = "FAILED"
+)
+
+// 수신 서버로 보내는 헤더
+const (
+	HeaderSignature = "X-MyRamen-Signature"
+	HeaderEventID   = "X-MyRamen-Event-Id"
+	HeaderEventType = "X-MyRamen-Event"
+	HeaderDelivery  = "X-MyRamen-Delivery"
+)
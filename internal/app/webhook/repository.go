@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"context"
+	"time"
+)
+
+// DeliveryFilter 전달 기록 조회 조건
+type DeliveryFilter struct {
+	SubscriptionID int64
+	Status         string
+	Limit          int
+}
+
+// Repository 웹훅 리포지토리 인터페이스
+type Repository interface {
+	// CreateSubscription 새로운 구독 생성
+	CreateSubscription(ctx context.Context, subscription *Subscription) error
+
+	// FindSubscriptionByID 구독 조회 (없으면 nil)
+	FindSubscriptionByID(ctx context.Context, id int64) (*Subscription, error)
+
+	// FindSubscriptions 모든 구독 조회
+	FindSubscriptions(ctx context.Context) ([]Subscription, error)
+
+	// FindActiveSubscriptions 해당 이벤트 유형을 구독 중인 활성 구독 조회
+	FindActiveSubscriptions(ctx context.Context, eventType string) ([]Subscription, error)
+
+	// UpdateSubscription 구독 정보 업데이트
+	UpdateSubscription(ctx context.Context, subscription *Subscription) error
+
+	// DeleteSubscription 구독과 전달 기록 삭제
+	DeleteSubscription(ctx context.Context, id int64) error
+
+	// CreateDelivery 전달 대기열에 추가
+	CreateDelivery(ctx context.Context, delivery *Delivery) error
+
+	// FindDeliveryByID 전달 기록 조회 (없으면 nil)
+	FindDeliveryByID(ctx context.Context, id int64) (*Delivery, error)
+
+	// FindDeliveries 조건에 맞는 전달 기록을 최신순으로 조회
+	FindDeliveries(ctx context.Context, filter DeliveryFilter) ([]Delivery, error)
+
+	// FindDueDeliveries 전달 시각이 된 대기 중 전달을 잠가서 조회 (다른 인스턴스가 잠근 행은 건너뜀)
+	// 트랜잭션 안에서 호출해야 한다.
+	FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]Delivery, error)
+
+	// LeaseDeliveries 전달 중인 동안 다른 인스턴스가 가져가지 않도록 다음 시도 시각을 미룸
+	LeaseDeliveries(ctx context.Context, ids []int64, until time.Time) error
+
+	// UpdateDeliveryResult 전달 결과(상태, 시도 횟수, 다음 시도 시각 등) 저장
+	UpdateDeliveryResult(ctx context.Context, delivery *Delivery) error
+
+	// AddAttempt 전달 시도 기록 추가
+	AddAttempt(ctx context.Context, attempt *Attempt) error
+
+	// FindAttempts 전달 시도 기록 조회
+	FindAttempts(ctx context.Context, deliveryID int64) ([]Attempt, error)
+}
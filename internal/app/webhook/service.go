@@ -0,0 +1,373 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/db"
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/idgen"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// DefaultDeliveryListLimit 전달 기록 기본 조회 개수
+const DefaultDeliveryListLimit = 50
+
+// secretPrefix 자동 생성한 서명 키 접두사
+const secretPrefix = "whsec_"
+
+// Service 웹훅 서비스
+type Service struct {
+	repo Repository
+	tx   db.Transactor
+}
+
+// NewService 웹훅 서비스 생성
+func NewService(repo Repository, tx db.Transactor) *Service {
+	return &Service{repo: repo, tx: tx}
+}
+
+// CreateSubscription 웹훅 구독 생성 (서명 키를 지정하지 않으면 자동 생성)
+func (s *Service) CreateSubscription(ctx context.Context, req CreateSubscriptionRequest) (*SubscriptionResponse, error) {
+	if err := validateURL(req.URL); err != nil {
+		return nil, err
+	}
+
+	if err := validateEventTypes(req.EventTypes); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		secret = secretPrefix + idgen.NewToken()
+	}
+
+	subscription := &Subscription{
+		URL:         req.URL,
+		Secret:      secret,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+		Active:      true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := s.repo.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	response := newSubscriptionResponse(subscription)
+	response.Secret = secret
+	return response, nil
+}
+
+// GetSubscriptions 웹훅 구독 목록 조회
+func (s *Service) GetSubscriptions(ctx context.Context) (*SubscriptionListResponse, error) {
+	subscriptions, err := s.repo.FindSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]SubscriptionResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		responses = append(responses, *newSubscriptionResponse(&subscription))
+	}
+
+	return &SubscriptionListResponse{Subscriptions: responses}, nil
+}
+
+// GetSubscription 웹훅 구독 조회
+func (s *Service) GetSubscription(ctx context.Context, id int64) (*SubscriptionResponse, error) {
+	subscription, err := s.findSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSubscriptionResponse(subscription), nil
+}
+
+// UpdateSubscription 웹훅 구독 수정
+func (s *Service) UpdateSubscription(ctx context.Context, id int64, req UpdateSubscriptionRequest) (*SubscriptionResponse, error) {
+	subscription, err := s.findSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := validateURL(*req.URL); err != nil {
+			return nil, err
+		}
+		subscription.URL = *req.URL
+	}
+
+	if req.EventTypes != nil {
+		if err := validateEventTypes(req.EventTypes); err != nil {
+			return nil, err
+		}
+		subscription.EventTypes = req.EventTypes
+	}
+
+	if req.Description != nil {
+		subscription.Description = *req.Description
+	}
+
+	if req.Active != nil {
+		subscription.Active = *req.Active
+	}
+
+	subscription.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return newSubscriptionResponse(subscription), nil
+}
+
+// DeleteSubscription 웹훅 구독 삭제 (전달 기록도 함께 삭제)
+func (s *Service) DeleteSubscription(ctx context.Context, id int64) error {
+	if _, err := s.findSubscription(ctx, id); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteSubscription(ctx, id)
+}
+
+// Enqueue 도메인 이벤트를 구독 중인 모든 웹훅의 전달 대기열에 추가
+// 호출한 쪽의 트랜잭션에 합류하며, 재전송되어도 같은 이벤트 ID를 사용한다.
+func (s *Service) Enqueue(ctx context.Context, e event.Event) error {
+	subscriptions, err := s.repo.FindActiveSubscriptions(ctx, e.Type)
+	if err != nil {
+		return err
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(Event{
+		ID:        e.ID,
+		Type:      e.Type,
+		CreatedAt: e.OccurredAt,
+		Data:      e.Payload,
+	})
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 이벤트를 만드는데 실패했습니다.")
+	}
+
+	now := time.Now()
+	for _, subscription := range subscriptions {
+		if err := s.repo.CreateDelivery(ctx, &Delivery{
+			SubscriptionID: subscription.ID,
+			EventID:        e.ID,
+			EventType:      e.Type,
+			Payload:        payload,
+			Status:         DeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetDeliveries 구독의 전달 기록을 최신순으로 조회
+func (s *Service) GetDeliveries(ctx context.Context, subscriptionID int64, req ListDeliveriesRequest) (*DeliveryListResponse, error) {
+	if _, err := s.findSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultDeliveryListLimit
+	}
+
+	deliveries, err := s.repo.FindDeliveries(ctx, DeliveryFilter{
+		SubscriptionID: subscriptionID,
+		Status:         req.Status,
+		Limit:          limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]DeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		responses = append(responses, *newDeliveryResponse(&delivery, nil))
+	}
+
+	return &DeliveryListResponse{Deliveries: responses}, nil
+}
+
+// GetDelivery 전달 기록과 시도 기록 조회
+func (s *Service) GetDelivery(ctx context.Context, id int64) (*DeliveryResponse, error) {
+	delivery, err := s.findDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := s.repo.FindAttempts(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDeliveryResponse(delivery, attempts), nil
+}
+
+// Redeliver 전달을 처음부터 다시 시도하도록 대기열에 되돌림 (같은 이벤트 ID로 전송)
+func (s *Service) Redeliver(ctx context.Context, id int64) (*DeliveryResponse, error) {
+	delivery, err := s.findDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Status = DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+	delivery.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateDeliveryResult(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	return newDeliveryResponse(delivery, nil), nil
+}
+
+// claimDueDeliveries 전달할 차례가 된 대기열 항목을 가져오고 lease 동안 다른 인스턴스가 가져가지 않도록 표시
+func (s *Service) claimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]Delivery, error) {
+	var deliveries []Delivery
+
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+
+		due, err := s.repo.FindDueDeliveries(ctx, now, limit)
+		if err != nil {
+			return err
+		}
+
+		if len(due) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, len(due))
+		for _, delivery := range due {
+			ids = append(ids, delivery.ID)
+		}
+
+		if err := s.repo.LeaseDeliveries(ctx, ids, now.Add(lease)); err != nil {
+			return err
+		}
+
+		deliveries = due
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// recordAttempt 전달 시도 기록과 전달 결과를 함께 저장
+func (s *Service) recordAttempt(ctx context.Context, delivery *Delivery, attempt *Attempt) error {
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.AddAttempt(ctx, attempt); err != nil {
+			return err
+		}
+
+		return s.repo.UpdateDeliveryResult(ctx, delivery)
+	})
+}
+
+func (s *Service) findSubscription(ctx context.Context, id int64) (*Subscription, error) {
+	subscription, err := s.repo.FindSubscriptionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription == nil {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	return subscription, nil
+}
+
+func (s *Service) findDelivery(ctx context.Context, id int64) (*Delivery, error) {
+	delivery, err := s.repo.FindDeliveryByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery == nil {
+		return nil, ErrDeliveryNotFound
+	}
+
+	return delivery, nil
+}
+
+// validateURL 절대 http/https URL인지 확인
+func validateURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
+// validateEventTypes 지원하는 이벤트 유형인지 확인
+func validateEventTypes(eventTypes []string) error {
+	if len(eventTypes) == 0 {
+		return ErrInvalidEventType
+	}
+
+	for _, eventType := range eventTypes {
+		supported := false
+		for _, known := range EventTypes {
+			if eventType == known {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return errors.NewError(
+				errors.StatusBadRequest,
+				"INVALID_EVENT_TYPE",
+				"지원하지 않는 이벤트 유형입니다: "+eventType,
+				map[string]interface{}{"supportedEventTypes": EventTypes},
+			)
+		}
+	}
+
+	return nil
+}
+
+func newSubscriptionResponse(subscription *Subscription) *SubscriptionResponse {
+	hint := subscription.Secret
+	if len(hint) > 4 {
+		hint = "…" + hint[len(hint)-4:]
+	}
+
+	return &SubscriptionResponse{
+		ID:          subscription.ID,
+		URL:         subscription.URL,
+		SecretHint:  hint,
+		EventTypes:  subscription.EventTypes,
+		Description: subscription.Description,
+		Active:      subscription.Active,
+		CreatedAt:   subscription.CreatedAt,
+		UpdatedAt:   subscription.UpdatedAt,
+	}
+}
+
+func newDeliveryResponse(delivery *Delivery, attempts []Attempt) *DeliveryResponse {
+	return &DeliveryResponse{
+		Delivery:   *delivery,
+		Payload:    json.RawMessage(delivery.Payload),
+		AttemptLog: attempts,
+	}
+}
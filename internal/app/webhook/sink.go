@@ -0,0 +1,27 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/myramen/be/internal/pkg/event"
+)
+
+// Sink 발행된 도메인 이벤트를 웹훅 전달 대기열에 넣는 event.Sink
+type Sink struct {
+	service *Service
+}
+
+// NewSink 웹훅 sink 생성
+func NewSink(service *Service) *Sink {
+	return &Sink{service: service}
+}
+
+// Name sink 이름
+func (s *Sink) Name() string {
+	return "webhook"
+}
+
+// Handle 이벤트를 구독 중인 웹훅의 전달 대기열에 추가 (relay 트랜잭션에 합류)
+func (s *Sink) Handle(ctx context.Context, e event.Event) error {
+	return s.service.Enqueue(ctx, e)
+}
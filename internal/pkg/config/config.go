@@ -1,17 +1,54 @@
 package config
 
 import (
+	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
 	AppPort       string
 	AdminPassword string
+	// 관리자별 인증 정보 ("이름:비밀번호"를 쉼표로 구분, 설정하면 AdminPassword 대신 사용)
+	AdminAccounts string
 	MySQLHost     string
 	MySQLPort     string
 	MySQLDatabase string
 	MySQLUsername string
 	MySQLPassword string
+
+	// 계좌번호 암호화 키 ("키ID:base64(32바이트)"를 쉼표로 구분)와 새 값에 사용할 키 ID
+	AccountEncryptionKeys  string
+	AccountEncryptionKeyID string
+
+	// 비로그인 주문을 계정에 연결할 때 쓰는 이름/계좌번호 해시 키
+	OrderClaimKey string
+
+	// 고객 로그인 세션 유효 기간
+	CustomerSessionTTL time.Duration
+
+	// 멱등성 키 보관 기간
+	IdempotencyTTL time.Duration
+
+	// outbox 이벤트 발행 주기
+	OutboxPollInterval time.Duration
+
+	// 웹훅 전달 대기열 확인 주기, 요청 타임아웃, 최대 시도 횟수
+	WebhookPollInterval time.Duration
+	WebhookTimeout      time.Duration
+	WebhookMaxAttempts  int
+
+	// 주방 화면 WebSocket 연결을 허용할 브라우저 Origin 목록
+	KitchenAllowedOrigins []string
+
+	// 스케줄러 리더 잠금 확인 주기
+	SchedulerInterval time.Duration
+
+	// 쿠폰 만료 처리 주기와 만료 임박 알림 시점 (0이면 알림 없음)
+	CouponExpiryInterval time.Duration
+	CouponExpiryReminder time.Duration
 }
 
 var AppConfig Config
@@ -20,11 +57,34 @@ func Load() {
 	AppConfig = Config{
 		AppPort:       getEnv("APP_PORT", "8080"),
 		AdminPassword: getEnv("ADMIN_PASSWORD", "admin1234"),
+		AdminAccounts: getEnv("ADMIN_ACCOUNTS", ""),
 		MySQLHost:     getEnv("DB_HOST", "localhost"),
 		MySQLPort:     getEnv("DB_PORT", "3306"),
 		MySQLDatabase: getEnv("DB_DATABASE", "myramen"),
 		MySQLUsername: getEnv("DB_USER", "root"),
 		MySQLPassword: getEnv("DB_PASSWORD", "password"),
+
+		AccountEncryptionKeys:  getEnv("ACCOUNT_ENCRYPTION_KEYS", ""),
+		AccountEncryptionKeyID: getEnv("ACCOUNT_ENCRYPTION_KEY_ID", ""),
+
+		OrderClaimKey: getEnv("ORDER_CLAIM_KEY", ""),
+
+		CustomerSessionTTL: getDurationEnv("CUSTOMER_SESSION_TTL", 30*24*time.Hour),
+
+		IdempotencyTTL: getDurationEnv("IDEMPOTENCY_TTL", 24*time.Hour),
+
+		OutboxPollInterval: getDurationEnv("OUTBOX_POLL_INTERVAL", 500*time.Millisecond),
+
+		WebhookPollInterval: getDurationEnv("WEBHOOK_POLL_INTERVAL", 2*time.Second),
+		WebhookTimeout:      getDurationEnv("WEBHOOK_TIMEOUT", 10*time.Second),
+		WebhookMaxAttempts:  getIntEnv("WEBHOOK_MAX_ATTEMPTS", 8),
+
+		KitchenAllowedOrigins: getListEnv("KITCHEN_ALLOWED_ORIGINS"),
+
+		SchedulerInterval: getDurationEnv("SCHEDULER_INTERVAL", 10*time.Second),
+
+		CouponExpiryInterval: getDurationEnv("COUPON_EXPIRY_INTERVAL", time.Minute),
+		CouponExpiryReminder: getDurationEnv("COUPON_EXPIRY_REMINDER", 72*time.Hour),
 	}
 }
 
@@ -35,3 +95,42 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+// getListEnv 쉼표로 구분한 값 목록 (빈 항목은 제외)
+func getListEnv(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Invalid duration for %s (%q), using default %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return duration
+}
+
+func getIntEnv(key string, defaultValue int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number <= 0 {
+		log.Printf("Invalid number for %s (%q), using default %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return number
+}
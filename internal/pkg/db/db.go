@@ -0,0 +1,14 @@
+package db
+
+import (
+	"context"
+)
+
+// Transactor 여러 리포지토리 작업을 하나의 트랜잭션으로 묶는 작업 단위(Unit of Work) 인터페이스
+type Transactor interface {
+	// WithinTx fn을 트랜잭션 안에서 실행
+	// fn이 에러를 반환하면 롤백하고, 그렇지 않으면 커밋한다.
+	// fn에 전달된 ctx를 리포지토리에 넘겨야 같은 트랜잭션에 참여한다.
+	// 이미 트랜잭션이 진행 중인 ctx로 호출하면 기존 트랜잭션에 합류한다.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
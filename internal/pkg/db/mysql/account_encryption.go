@@ -0,0 +1,90 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/myramen/be/internal/pkg/encryption"
+)
+
+// EncryptAccountNumbers 평문이거나 활성 키가 아닌 키로 암호화된 계좌번호를 활성 키로 다시 암호화
+// batchSize 행씩 나누어 처리하고 변경한 행 수를 반환한다. 중단되어도 다시 실행하면 이어서 처리한다.
+func EncryptAccountNumbers(ctx context.Context, db *sql.DB, keyring *encryption.Keyring, batchSize int) (int, error) {
+	if !keyring.Enabled() {
+		return 0, fmt.Errorf("account encryption keys are not configured")
+	}
+
+	activeKeyID := keyring.ActiveKeyID()
+	updated := 0
+	var lastID int64
+
+	for {
+		rows, err := db.QueryContext(ctx, `
+			SELECT id, account_number, account_key_id
+			FROM orders
+			WHERE id > ? AND (account_key_id IS NULL OR account_key_id <> ?)
+			ORDER BY id
+			LIMIT ?
+		`, lastID, activeKeyID, batchSize)
+		if err != nil {
+			return updated, fmt.Errorf("select orders: %w", err)
+		}
+
+		type accountRow struct {
+			id            int64
+			accountNumber string
+			keyID         sql.NullString
+		}
+
+		var batch []accountRow
+		for rows.Next() {
+			var row accountRow
+			if err := rows.Scan(&row.id, &row.accountNumber, &row.keyID); err != nil {
+				rows.Close()
+				return updated, fmt.Errorf("scan order: %w", err)
+			}
+			batch = append(batch, row)
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return updated, fmt.Errorf("iterate orders: %w", err)
+		}
+
+		if len(batch) == 0 {
+			return updated, nil
+		}
+
+		for _, row := range batch {
+			lastID = row.id
+
+			plaintext := row.accountNumber
+			if row.keyID.Valid {
+				plaintext, err = keyring.Decrypt(row.accountNumber, row.keyID.String)
+				if err != nil {
+					return updated, fmt.Errorf("decrypt order %d: %w", row.id, err)
+				}
+			}
+
+			ciphertext, keyID, err := keyring.Encrypt(plaintext)
+			if err != nil {
+				return updated, fmt.Errorf("encrypt order %d: %w", row.id, err)
+			}
+
+			// 그 사이 다른 프로세스가 변경한 행은 건너뜀
+			result, err := db.ExecContext(ctx, `
+				UPDATE orders
+				SET account_number = ?, account_key_id = ?
+				WHERE id = ? AND account_key_id <=> ?
+			`, ciphertext, keyID, row.id, row.keyID)
+			if err != nil {
+				return updated, fmt.Errorf("update order %d: %w", row.id, err)
+			}
+
+			if n, err := result.RowsAffected(); err == nil {
+				updated += int(n)
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/myramen/be/internal/pkg/scheduler"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// AdvisoryLocker MySQL GET_LOCK 기반 잠금
+// 잠금은 DB 연결에 묶이므로 잠금마다 전용 연결을 잡아 두고, 연결이 끊기면 잠금도 풀린다.
+type AdvisoryLocker struct {
+	db *sql.DB
+}
+
+// NewAdvisoryLocker MySQL 잠금 생성
+func NewAdvisoryLocker(db *sql.DB) *AdvisoryLocker {
+	return &AdvisoryLocker{db: db}
+}
+
+func (l *AdvisoryLocker) TryLock(ctx context.Context, name string) (scheduler.Lock, error) {
+	c, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "잠금용 DB 연결을 가져오는데 실패했습니다.")
+	}
+
+	// 1: 획득, 0: 다른 연결이 가지고 있음, NULL: 오류
+	var acquired sql.NullInt64
+	if err := c.QueryRowContext(ctx, `SELECT GET_LOCK(?, 0)`, name).Scan(&acquired); err != nil {
+		_ = c.Close()
+		return nil, errors.Internal("INTERNAL_ERROR", "잠금을 획득하는데 실패했습니다.")
+	}
+
+	if acquired.Int64 != 1 {
+		_ = c.Close()
+		return nil, nil
+	}
+
+	return &advisoryLock{conn: c, name: name}, nil
+}
+
+type advisoryLock struct {
+	conn *sql.Conn
+	name string
+}
+
+func (l *advisoryLock) Held(ctx context.Context) bool {
+	var held sql.NullBool
+	err := l.conn.QueryRowContext(ctx, `SELECT IS_USED_LOCK(?) = CONNECTION_ID()`, l.name).Scan(&held)
+	return err == nil && held.Valid && held.Bool
+}
+
+func (l *advisoryLock) Release(ctx context.Context) error {
+	if _, err := l.conn.ExecContext(ctx, `DO RELEASE_LOCK(?)`, l.name); err != nil {
+		// 잠금이 남아 있을 수 있으므로 연결을 풀에 돌려보내지 않고 버려서 서버가 잠금을 풀게 함
+		_ = l.conn.Raw(func(driverConn interface{}) error { return driver.ErrBadConn })
+		_ = l.conn.Close()
+		return errors.Internal("INTERNAL_ERROR", "잠금을 해제하는데 실패했습니다.")
+	}
+
+	return l.conn.Close()
+}
@@ -0,0 +1,254 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/myramen/be/internal/app/campaign"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+type campaignRepository struct {
+	db *sql.DB
+}
+
+func NewCampaignRepository(db *sql.DB) campaign.Repository {
+	return &campaignRepository{db: db}
+}
+
+func (r *campaignRepository) Create(ctx context.Context, c *campaign.Campaign) error {
+	minQuantity, discount, validDays := rewardColumns(c.Reward)
+
+	query := `
+		INSERT INTO campaigns (
+			name, active, starts_at, ends_at, budget, max_redemptions, max_per_customer,
+			reward_min_quantity, reward_discount, reward_valid_days, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		c.Name, c.Active, c.StartsAt, c.EndsAt, c.Budget, c.MaxRedemptions, c.MaxPerCustomer,
+		minQuantity, discount, validDays, c.CreatedAt, c.UpdatedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "캠페인을 저장하는데 실패했습니다.")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "캠페인 ID를 확인하는데 실패했습니다.")
+	}
+	c.ID = id
+
+	return nil
+}
+
+// rewardColumns 구매 보상 규칙을 컬럼 값으로 변환 (규칙이 없으면 모두 NULL)
+func rewardColumns(reward *campaign.RewardRule) (sql.NullInt64, sql.NullInt64, sql.NullInt64) {
+	if reward == nil {
+		return sql.NullInt64{}, sql.NullInt64{}, sql.NullInt64{}
+	}
+
+	return sql.NullInt64{Int64: int64(reward.MinQuantity), Valid: true},
+		sql.NullInt64{Int64: int64(reward.Discount), Valid: true},
+		sql.NullInt64{Int64: int64(reward.ValidDays), Valid: true}
+}
+
+// campaignColumns 캠페인 조회 시 사용하는 컬럼 목록 (scanCampaign과 순서가 같아야 함)
+// 발급 쿠폰 수는 하위 쿼리로 세며, FOR UPDATE로 조회해도 쿠폰 행은 잠그지 않는다.
+const campaignColumns = `
+	id, name, active, starts_at, ends_at, budget, max_redemptions, max_per_customer,
+	reward_min_quantity, reward_discount, reward_valid_days, spent, redemptions,
+	(SELECT COUNT(*) FROM coupons WHERE coupons.campaign_id = campaigns.id), created_at, updated_at
+`
+
+// scanCampaign 조회 결과 한 행을 캠페인으로 변환
+func scanCampaign(scanner rowScanner) (*campaign.Campaign, error) {
+	var (
+		c           campaign.Campaign
+		startsAt    sql.NullTime
+		endsAt      sql.NullTime
+		minQuantity sql.NullInt64
+		discount    sql.NullInt64
+		validDays   sql.NullInt64
+	)
+
+	if err := scanner.Scan(
+		&c.ID, &c.Name, &c.Active, &startsAt, &endsAt, &c.Budget, &c.MaxRedemptions, &c.MaxPerCustomer,
+		&minQuantity, &discount, &validDays, &c.Spent, &c.Redemptions, &c.IssuedCount,
+		&c.CreatedAt, &c.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if startsAt.Valid {
+		c.StartsAt = &startsAt.Time
+	}
+
+	if endsAt.Valid {
+		c.EndsAt = &endsAt.Time
+	}
+
+	if minQuantity.Valid {
+		c.Reward = &campaign.RewardRule{
+			MinQuantity: int(minQuantity.Int64),
+			Discount:    int(discount.Int64),
+			ValidDays:   int(validDays.Int64),
+		}
+	}
+
+	return &c, nil
+}
+
+func (r *campaignRepository) FindByID(ctx context.Context, id int64) (*campaign.Campaign, error) {
+	return r.findOne(ctx, `SELECT `+campaignColumns+` FROM campaigns WHERE id = ?`, id)
+}
+
+func (r *campaignRepository) FindByIDForUpdate(ctx context.Context, id int64) (*campaign.Campaign, error) {
+	return r.findOne(ctx, `SELECT `+campaignColumns+` FROM campaigns WHERE id = ? FOR UPDATE`, id)
+}
+
+func (r *campaignRepository) findOne(ctx context.Context, query string, id int64) (*campaign.Campaign, error) {
+	c, err := scanCampaign(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "캠페인을 조회하는데 실패했습니다.")
+	}
+
+	return c, nil
+}
+
+func (r *campaignRepository) FindAll(ctx context.Context) ([]campaign.Campaign, error) {
+	query := `SELECT ` + campaignColumns + ` FROM campaigns ORDER BY id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "캠페인 목록을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var campaigns []campaign.Campaign
+	for rows.Next() {
+		c, err := scanCampaign(rows)
+		if err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "캠페인 정보를 읽는데 실패했습니다.")
+		}
+
+		campaigns = append(campaigns, *c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "캠페인 목록을 조회하는데 실패했습니다.")
+	}
+
+	return campaigns, nil
+}
+
+func (r *campaignRepository) Update(ctx context.Context, c *campaign.Campaign) error {
+	minQuantity, discount, validDays := rewardColumns(c.Reward)
+
+	query := `
+		UPDATE campaigns
+		SET name = ?, active = ?, starts_at = ?, ends_at = ?, budget = ?, max_redemptions = ?, max_per_customer = ?,
+			reward_min_quantity = ?, reward_discount = ?, reward_valid_days = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		c.Name, c.Active, c.StartsAt, c.EndsAt, c.Budget, c.MaxRedemptions, c.MaxPerCustomer,
+		minQuantity, discount, validDays, c.UpdatedAt, c.ID,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "캠페인을 업데이트하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *campaignRepository) CountRedemptions(ctx context.Context, campaignID int64, customerKey string) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM campaign_redemptions
+		WHERE campaign_id = ? AND customer_key = ? AND refunded_at IS NULL
+	`
+
+	var count int
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, campaignID, customerKey).Scan(&count); err != nil {
+		return 0, errors.Internal("INTERNAL_ERROR", "캠페인 쿠폰 사용 횟수를 조회하는데 실패했습니다.")
+	}
+
+	return count, nil
+}
+
+func (r *campaignRepository) AddRedemption(ctx context.Context, redemption *campaign.Redemption) error {
+	query := `
+		INSERT INTO campaign_redemptions (
+			campaign_id, coupon_id, order_id, customer_key, amount, created_at
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		redemption.CampaignID, redemption.CouponID, redemption.OrderID, redemption.CustomerKey,
+		redemption.Amount, redemption.CreatedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "캠페인 쿠폰 사용 기록을 저장하는데 실패했습니다.")
+	}
+
+	if id, err := result.LastInsertId(); err == nil {
+		redemption.ID = id
+	}
+
+	query = `UPDATE campaigns SET spent = spent + ?, redemptions = redemptions + 1 WHERE id = ?`
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, redemption.Amount, redemption.CampaignID); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "캠페인 사용 금액을 업데이트하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *campaignRepository) RefundRedemption(ctx context.Context, orderID string, refundedAt time.Time) (*campaign.Redemption, error) {
+	query := `
+		SELECT id, campaign_id, coupon_id, order_id, customer_key, amount, created_at
+		FROM campaign_redemptions
+		WHERE order_id = ? AND refunded_at IS NULL
+		FOR UPDATE
+	`
+
+	var redemption campaign.Redemption
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, orderID).Scan(
+		&redemption.ID, &redemption.CampaignID, &redemption.CouponID, &redemption.OrderID,
+		&redemption.CustomerKey, &redemption.Amount, &redemption.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "캠페인 쿠폰 사용 기록을 조회하는데 실패했습니다.")
+	}
+
+	query = `UPDATE campaign_redemptions SET refunded_at = ? WHERE id = ?`
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, refundedAt, redemption.ID); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "캠페인 쿠폰 사용 기록을 취소하는데 실패했습니다.")
+	}
+
+	query = `
+		UPDATE campaigns
+		SET spent = GREATEST(CAST(spent AS SIGNED) - ?, 0), redemptions = GREATEST(CAST(redemptions AS SIGNED) - 1, 0)
+		WHERE id = ?
+	`
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, redemption.Amount, redemption.CampaignID); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "캠페인 사용 금액을 되돌리는데 실패했습니다.")
+	}
+
+	redemption.RefundedAt = &refundedAt
+	return &redemption, nil
+}
@@ -3,6 +3,8 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
 
 	"github.com/myramen/be/internal/app/coupon"
 	"github.com/myramen/be/internal/pkg/utils/errors"
@@ -17,15 +19,24 @@ func NewCouponRepository(db *sql.DB) coupon.Repository {
 }
 
 func (r *couponRepository) Create(ctx context.Context, coupon *coupon.Coupon) error {
+	conditionsJSON, err := marshalCouponConditions(coupon.Conditions)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		INSERT INTO coupons (
-			coupon_id, discount, expiry_date, is_used, issued_at
-		) VALUES (?, ?, ?, ?, ?)
+			coupon_id, customer_id, owner_key, batch_id, campaign_id, coupon_type, discount, discount_percent,
+			max_discount, free_option, conditions, expiry_date, is_used, issued_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	_, err := r.db.ExecContext(
+	_, err = conn(ctx, r.db).ExecContext(
 		ctx, query,
-		coupon.CouponID, coupon.Discount, coupon.ExpiryDate, coupon.IsUsed, coupon.IssuedAt,
+		coupon.CouponID, nullInt64(coupon.CustomerID), nullString(coupon.OwnerKey), nullInt64(coupon.BatchID), nullInt64(coupon.CampaignID),
+		coupon.Type,
+		coupon.Discount, coupon.DiscountPercent, coupon.MaxDiscount, nullString(coupon.FreeOption), conditionsJSON,
+		coupon.ExpiryDate, coupon.IsUsed, coupon.IssuedAt,
 	)
 
 	if err != nil {
@@ -35,40 +46,190 @@ func (r *couponRepository) Create(ctx context.Context, coupon *coupon.Coupon) er
 	return nil
 }
 
+// couponColumns 쿠폰 조회 시 사용하는 컬럼 목록 (scanCoupon과 순서가 같아야 함)
+const couponColumns = `
+	coupon_id, customer_id, owner_key, batch_id, campaign_id, coupon_type, discount, discount_percent, max_discount, free_option,
+	conditions, expiry_date, is_used, used_by_order_id, used_at, revoked_at, revoked_reason, expired_at,
+	expiry_reminded_at, issued_at
+`
+
+// scanCoupon 조회 결과 한 행을 쿠폰으로 변환
+func scanCoupon(scanner rowScanner) (*coupon.Coupon, error) {
+	var (
+		couponResult   coupon.Coupon
+		customerID     sql.NullInt64
+		ownerKey       sql.NullString
+		batchID        sql.NullInt64
+		campaignID     sql.NullInt64
+		freeOption     sql.NullString
+		conditionsJSON []byte
+		usedByOrderID  sql.NullString
+		usedAt         sql.NullTime
+		revokedAt      sql.NullTime
+		revokedReason  sql.NullString
+		expiredAt      sql.NullTime
+		remindedAt     sql.NullTime
+	)
+
+	if err := scanner.Scan(
+		&couponResult.CouponID, &customerID, &ownerKey, &batchID, &campaignID, &couponResult.Type, &couponResult.Discount,
+		&couponResult.DiscountPercent, &couponResult.MaxDiscount, &freeOption, &conditionsJSON, &couponResult.ExpiryDate,
+		&couponResult.IsUsed, &usedByOrderID, &usedAt, &revokedAt, &revokedReason, &expiredAt,
+		&remindedAt, &couponResult.IssuedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	couponResult.CustomerID = customerID.Int64
+	couponResult.OwnerKey = ownerKey.String
+	couponResult.BatchID = batchID.Int64
+	couponResult.CampaignID = campaignID.Int64
+	couponResult.FreeOption = freeOption.String
+	if conditionsJSON != nil {
+		if err := json.Unmarshal(conditionsJSON, &couponResult.Conditions); err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "쿠폰 사용 조건을 파싱하는데 실패했습니다.")
+		}
+	}
+
+	couponResult.UsedByOrderID = usedByOrderID.String
+	if usedAt.Valid {
+		couponResult.UsedAt = &usedAt.Time
+	}
+
+	if revokedAt.Valid {
+		couponResult.RevokedAt = &revokedAt.Time
+	}
+	couponResult.RevokedReason = revokedReason.String
+
+	if expiredAt.Valid {
+		couponResult.ExpiredAt = &expiredAt.Time
+	}
+
+	if remindedAt.Valid {
+		couponResult.ExpiryRemindedAt = &remindedAt.Time
+	}
+
+	return &couponResult, nil
+}
+
+// marshalCouponConditions 쿠폰 사용 조건을 JSON으로 변환 (조건이 없으면 NULL)
+func marshalCouponConditions(conditions coupon.Conditions) ([]byte, error) {
+	if conditions.MinOrderAmount == 0 && len(conditions.DeliveryOptions) == 0 && len(conditions.MenuItemIDs) == 0 {
+		return nil, nil
+	}
+
+	conditionsJSON, err := json.Marshal(conditions)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "쿠폰 사용 조건을 JSON으로 변환하는데 실패했습니다.")
+	}
+
+	return conditionsJSON, nil
+}
+
 func (r *couponRepository) FindByID(ctx context.Context, couponID string) (*coupon.Coupon, error) {
+	query := `SELECT ` + couponColumns + ` FROM coupons WHERE coupon_id = ?`
+
+	couponResult, err := scanCoupon(conn(ctx, r.db).QueryRowContext(ctx, query, couponID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "쿠폰을 조회하는데 실패했습니다.")
+	}
+
+	return couponResult, nil
+}
+
+func (r *couponRepository) FindByIDForUpdate(ctx context.Context, couponID string) (*coupon.Coupon, error) {
+	query := `SELECT ` + couponColumns + ` FROM coupons WHERE coupon_id = ? FOR UPDATE`
+
+	couponResult, err := scanCoupon(conn(ctx, r.db).QueryRowContext(ctx, query, couponID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "쿠폰을 조회하는데 실패했습니다.")
+	}
+
+	return couponResult, nil
+}
+
+func (r *couponRepository) FindByBatch(ctx context.Context, batchID int64) ([]coupon.Coupon, error) {
 	query := `
-		SELECT coupon_id, discount, expiry_date, is_used, issued_at
-		FROM coupons 
-		WHERE coupon_id = ?
+		SELECT ` + couponColumns + `
+		FROM coupons
+		WHERE batch_id = ?
+		ORDER BY issued_at, coupon_id
 	`
 
-	var couponResult coupon.Coupon
+	return r.findMany(ctx, query, batchID)
+}
 
-	err := r.db.QueryRowContext(ctx, query, couponID).Scan(
-		&couponResult.CouponID, &couponResult.Discount, &couponResult.ExpiryDate,
-		&couponResult.IsUsed, &couponResult.IssuedAt,
-	)
+func (r *couponRepository) CreateBatch(ctx context.Context, batch *coupon.Batch) error {
+	query := `
+		INSERT INTO coupon_batches (
+			name, coupon_count, created_by, created_at
+		) VALUES (?, ?, ?, ?)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, batch.Name, batch.CouponCount, batch.CreatedBy, batch.CreatedAt)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "쿠폰 발급 묶음을 생성하는데 실패했습니다.")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "쿠폰 발급 묶음 ID를 확인하는데 실패했습니다.")
+	}
+	batch.ID = id
+
+	return nil
+}
+
+func (r *couponRepository) FindBatch(ctx context.Context, batchID int64) (*coupon.Batch, error) {
+	query := `SELECT id, name, coupon_count, created_by, created_at FROM coupon_batches WHERE id = ?`
 
+	var batch coupon.Batch
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, batchID).Scan(
+		&batch.ID, &batch.Name, &batch.CouponCount, &batch.CreatedBy, &batch.CreatedAt,
+	)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 
 	if err != nil {
-		return nil, errors.Internal("INTERNAL_ERROR", "쿠폰을 조회하는데 실패했습니다.")
+		return nil, errors.Internal("INTERNAL_ERROR", "쿠폰 발급 묶음을 조회하는데 실패했습니다.")
 	}
 
-	return &couponResult, nil
+	return &batch, nil
 }
 
 func (r *couponRepository) FindAll(ctx context.Context) ([]coupon.Coupon, error) {
 	query := `
-		SELECT coupon_id, discount, expiry_date, is_used, issued_at
+		SELECT ` + couponColumns + `
 		FROM coupons
-		WHERE is_used = FALSE AND expiry_date > NOW()
+		WHERE is_used = FALSE AND revoked_at IS NULL AND expiry_date > NOW()
 		ORDER BY issued_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	return r.findMany(ctx, query)
+}
+
+func (r *couponRepository) FindByCustomer(ctx context.Context, customerID int64) ([]coupon.Coupon, error) {
+	query := `
+		SELECT ` + couponColumns + `
+		FROM coupons
+		WHERE customer_id = ?
+		ORDER BY issued_at DESC
+	`
+
+	return r.findMany(ctx, query, customerID)
+}
+
+func (r *couponRepository) findMany(ctx context.Context, query string, args ...interface{}) ([]coupon.Coupon, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.Internal("INTERNAL_ERROR", "쿠폰을 조회하는데 실패했습니다.")
 	}
@@ -77,16 +238,12 @@ func (r *couponRepository) FindAll(ctx context.Context) ([]coupon.Coupon, error)
 	var coupons []coupon.Coupon
 
 	for rows.Next() {
-		var couponItem coupon.Coupon
-
-		if err := rows.Scan(
-			&couponItem.CouponID, &couponItem.Discount, &couponItem.ExpiryDate,
-			&couponItem.IsUsed, &couponItem.IssuedAt,
-		); err != nil {
+		couponItem, err := scanCoupon(rows)
+		if err != nil {
 			return nil, errors.Internal("INTERNAL_ERROR", "쿠폰 정보를 파싱하는데 실패했습니다.")
 		}
 
-		coupons = append(coupons, couponItem)
+		coupons = append(coupons, *couponItem)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -97,15 +254,24 @@ func (r *couponRepository) FindAll(ctx context.Context) ([]coupon.Coupon, error)
 }
 
 func (r *couponRepository) Update(ctx context.Context, coupon *coupon.Coupon) error {
+	conditionsJSON, err := marshalCouponConditions(coupon.Conditions)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE coupons 
-		SET discount = ?, expiry_date = ?, is_used = ?
+		SET coupon_type = ?, discount = ?, discount_percent = ?, max_discount = ?, free_option = ?,
+			conditions = ?, expiry_date = ?, is_used = ?, revoked_at = ?, revoked_reason = ?,
+			expired_at = ?, expiry_reminded_at = ?
 		WHERE coupon_id = ?
 	`
 
-	result, err := r.db.ExecContext(
+	result, err := conn(ctx, r.db).ExecContext(
 		ctx, query,
-		coupon.Discount, coupon.ExpiryDate, coupon.IsUsed, coupon.CouponID,
+		coupon.Type, coupon.Discount, coupon.DiscountPercent, coupon.MaxDiscount, nullString(coupon.FreeOption),
+		conditionsJSON, coupon.ExpiryDate, coupon.IsUsed, coupon.RevokedAt, nullString(coupon.RevokedReason),
+		coupon.ExpiredAt, coupon.ExpiryRemindedAt, coupon.CouponID,
 	)
 
 	if err != nil {
@@ -124,14 +290,117 @@ func (r *couponRepository) Update(ctx context.Context, coupon *coupon.Coupon) er
 	return nil
 }
 
-func (r *couponRepository) MarkAsUsed(ctx context.Context, couponID string) error {
+func (r *couponRepository) AssignCustomer(ctx context.Context, couponID string, customerID int64) error {
+	query := `UPDATE coupons SET customer_id = ?, owner_key = NULL WHERE coupon_id = ? AND customer_id IS NULL`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, customerID, couponID); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "쿠폰을 고객에게 연결하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *couponRepository) ChangeOwner(ctx context.Context, couponID string, fromCustomerID int64, toCustomerID int64, at time.Time) (bool, error) {
 	query := `
-		UPDATE coupons 
-		SET is_used = TRUE
+		UPDATE coupons
+		SET customer_id = ?, owner_key = NULL
+		WHERE coupon_id = ? AND customer_id = ? AND is_used = FALSE AND revoked_at IS NULL AND expiry_date > ?
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, toCustomerID, couponID, fromCustomerID, at)
+	if err != nil {
+		return false, errors.Internal("INTERNAL_ERROR", "쿠폰 소유자를 변경하는데 실패했습니다.")
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Internal("INTERNAL_ERROR", "영향받은 행 수를 확인하는데 실패했습니다.")
+	}
+
+	return rows > 0, nil
+}
+
+func (r *couponRepository) AddAuditEntry(ctx context.Context, entry *coupon.AuditEntry) error {
+	var detailsJSON []byte
+	if len(entry.Details) > 0 {
+		var err error
+		detailsJSON, err = json.Marshal(entry.Details)
+		if err != nil {
+			return errors.Internal("INTERNAL_ERROR", "쿠폰 감사 기록을 JSON으로 변환하는데 실패했습니다.")
+		}
+	}
+
+	query := `
+		INSERT INTO coupon_audit_log (
+			coupon_id, action, actor, details, created_at
+		) VALUES (?, ?, ?, ?, ?)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		entry.CouponID, entry.Action, entry.Actor, detailsJSON, entry.CreatedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "쿠폰 감사 기록을 저장하는데 실패했습니다.")
+	}
+
+	if id, err := result.LastInsertId(); err == nil {
+		entry.ID = id
+	}
+
+	return nil
+}
+
+func (r *couponRepository) FindAuditLog(ctx context.Context, couponID string) ([]coupon.AuditEntry, error) {
+	query := `
+		SELECT id, coupon_id, action, actor, details, created_at
+		FROM coupon_audit_log
 		WHERE coupon_id = ?
+		ORDER BY id
 	`
 
-	result, err := r.db.ExecContext(ctx, query, couponID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, couponID)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "쿠폰 감사 기록을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var entries []coupon.AuditEntry
+	for rows.Next() {
+		var (
+			entry       coupon.AuditEntry
+			detailsJSON []byte
+		)
+
+		if err := rows.Scan(&entry.ID, &entry.CouponID, &entry.Action, &entry.Actor, &detailsJSON, &entry.CreatedAt); err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "쿠폰 감사 기록을 읽는데 실패했습니다.")
+		}
+
+		if detailsJSON != nil {
+			if err := json.Unmarshal(detailsJSON, &entry.Details); err != nil {
+				return nil, errors.Internal("INTERNAL_ERROR", "쿠폰 감사 기록을 파싱하는데 실패했습니다.")
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "쿠폰 감사 기록을 조회하는데 실패했습니다.")
+	}
+
+	return entries, nil
+}
+
+func (r *couponRepository) Redeem(ctx context.Context, couponID string, orderID string, redeemedAt time.Time) error {
+	// 조건부 UPDATE로 미사용/미만료 검사와 사용 처리를 한 번에 수행
+	query := `
+		UPDATE coupons
+		SET is_used = TRUE, used_by_order_id = ?, used_at = ?
+		WHERE coupon_id = ? AND is_used = FALSE AND revoked_at IS NULL AND expiry_date > ?
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, orderID, redeemedAt, couponID, redeemedAt)
 	if err != nil {
 		return errors.Internal("INTERNAL_ERROR", "쿠폰 사용 처리에 실패했습니다.")
 	}
@@ -141,8 +410,98 @@ func (r *couponRepository) MarkAsUsed(ctx context.Context, couponID string) erro
 		return errors.Internal("INTERNAL_ERROR", "영향받은 행 수를 확인하는데 실패했습니다.")
 	}
 
-	if rows == 0 {
-		return errors.NotFound("NOT_FOUND", "해당 쿠폰을 찾을 수 없습니다.")
+	if rows == 1 {
+		return nil
+	}
+
+	// 실패 사유 확인 (트랜잭션 스냅샷이 아닌 최신 상태를 읽기 위해 잠금 읽기 사용)
+	query = `SELECT is_used, revoked_at, expiry_date FROM coupons WHERE coupon_id = ? FOR UPDATE`
+
+	var (
+		isUsed     bool
+		revokedAt  sql.NullTime
+		expiryDate time.Time
+	)
+
+	err = conn(ctx, r.db).QueryRowContext(ctx, query, couponID).Scan(&isUsed, &revokedAt, &expiryDate)
+	if err == sql.ErrNoRows {
+		return coupon.ErrCouponNotFound
+	}
+
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "쿠폰을 조회하는데 실패했습니다.")
+	}
+
+	if isUsed {
+		return coupon.ErrCouponAlreadyRedeemed
+	}
+
+	if revokedAt.Valid {
+		return coupon.ErrCouponRevoked
+	}
+
+	return coupon.ErrCouponExpired
+}
+
+func (r *couponRepository) Release(ctx context.Context, couponID string, orderID string) error {
+	// 다른 주문이 사용한 쿠폰은 복원하지 않음 (사용 주문 기록 이전의 쿠폰은 허용)
+	query := `
+		UPDATE coupons
+		SET is_used = FALSE, used_by_order_id = NULL, used_at = NULL
+		WHERE coupon_id = ? AND is_used = TRUE
+			AND (used_by_order_id = ? OR used_by_order_id IS NULL)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, couponID, orderID)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "쿠폰 복원 처리에 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *couponRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]coupon.Coupon, error) {
+	query := `
+		SELECT ` + couponColumns + `
+		FROM coupons
+		WHERE is_used = FALSE AND revoked_at IS NULL AND expired_at IS NULL AND expiry_date <= ?
+		ORDER BY expiry_date, coupon_id
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED
+	`
+
+	return r.findMany(ctx, query, now, limit)
+}
+
+func (r *couponRepository) MarkExpired(ctx context.Context, couponID string, expiredAt time.Time) error {
+	query := `UPDATE coupons SET expired_at = ? WHERE coupon_id = ?`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, expiredAt, couponID); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "쿠폰 만료 처리에 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *couponRepository) FindExpiring(ctx context.Context, now time.Time, before time.Time, limit int) ([]coupon.Coupon, error) {
+	query := `
+		SELECT ` + couponColumns + `
+		FROM coupons
+		WHERE is_used = FALSE AND revoked_at IS NULL AND expiry_reminded_at IS NULL
+			AND expiry_date > ? AND expiry_date <= ?
+		ORDER BY expiry_date, coupon_id
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED
+	`
+
+	return r.findMany(ctx, query, now, before, limit)
+}
+
+func (r *couponRepository) MarkReminded(ctx context.Context, couponID string, remindedAt time.Time) error {
+	query := `UPDATE coupons SET expiry_reminded_at = ? WHERE coupon_id = ?`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, remindedAt, couponID); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "쿠폰 만료 임박 알림을 기록하는데 실패했습니다.")
 	}
 
 	return nil
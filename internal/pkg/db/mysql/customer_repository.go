@@ -0,0 +1,138 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/myramen/be/internal/app/customer"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+type customerRepository struct {
+	db *sql.DB
+}
+
+func NewCustomerRepository(db *sql.DB) customer.Repository {
+	return &customerRepository{db: db}
+}
+
+func (r *customerRepository) Create(ctx context.Context, c *customer.Customer) error {
+	query := `
+		INSERT INTO customers (
+			email, name, password_hash, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		c.Email, c.Name, c.PasswordHash, c.CreatedAt, c.UpdatedAt,
+	)
+
+	if isDuplicateEntry(err) {
+		return customer.ErrEmailTaken
+	}
+
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "고객 정보를 저장하는데 실패했습니다.")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "고객 ID를 확인하는데 실패했습니다.")
+	}
+	c.ID = id
+
+	return nil
+}
+
+// customerColumns 고객 조회 시 사용하는 컬럼 목록 (scanCustomer와 순서가 같아야 함)
+const customerColumns = `
+	id, email, name, password_hash, created_at, updated_at
+`
+
+// scanCustomer 조회 결과 한 행을 고객으로 변환
+func scanCustomer(scanner rowScanner) (*customer.Customer, error) {
+	var c customer.Customer
+
+	if err := scanner.Scan(
+		&c.ID, &c.Email, &c.Name, &c.PasswordHash, &c.CreatedAt, &c.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *customerRepository) FindByID(ctx context.Context, id int64) (*customer.Customer, error) {
+	query := `SELECT ` + customerColumns + ` FROM customers WHERE id = ?`
+
+	return r.findOne(ctx, query, id)
+}
+
+func (r *customerRepository) FindByEmail(ctx context.Context, email string) (*customer.Customer, error) {
+	query := `SELECT ` + customerColumns + ` FROM customers WHERE email = ?`
+
+	return r.findOne(ctx, query, email)
+}
+
+func (r *customerRepository) findOne(ctx context.Context, query string, args ...interface{}) (*customer.Customer, error) {
+	c, err := scanCustomer(conn(ctx, r.db).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "고객 정보를 조회하는데 실패했습니다.")
+	}
+
+	return c, nil
+}
+
+func (r *customerRepository) CreateSession(ctx context.Context, session *customer.Session) error {
+	query := `
+		INSERT INTO customer_sessions (
+			token_hash, customer_id, expires_at, created_at
+		) VALUES (?, ?, ?, ?)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		session.TokenHash, session.CustomerID, session.ExpiresAt, session.CreatedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "로그인 세션을 저장하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *customerRepository) FindSession(ctx context.Context, tokenHash string) (*customer.Session, error) {
+	query := `
+		SELECT token_hash, customer_id, expires_at, created_at
+		FROM customer_sessions
+		WHERE token_hash = ?
+	`
+
+	var session customer.Session
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, tokenHash).Scan(
+		&session.TokenHash, &session.CustomerID, &session.ExpiresAt, &session.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "로그인 세션을 조회하는데 실패했습니다.")
+	}
+
+	return &session, nil
+}
+
+func (r *customerRepository) DeleteSession(ctx context.Context, tokenHash string) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM customer_sessions WHERE token_hash = ?`, tokenHash)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "로그인 세션을 삭제하는데 실패했습니다.")
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/middleware"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	driver "github.com/go-sql-driver/mysql"
+)
+
+// mysqlErrDuplicateEntry UNIQUE 키 중복 에러 번호
+const mysqlErrDuplicateEntry = 1062
+
+type idempotencyRepository struct {
+	db *sql.DB
+}
+
+func NewIdempotencyRepository(db *sql.DB) middleware.IdempotencyStore {
+	return &idempotencyRepository{db: db}
+}
+
+func (r *idempotencyRepository) Reserve(ctx context.Context, record *middleware.IdempotencyRecord) (*middleware.IdempotencyRecord, error) {
+	insertQuery := `
+		INSERT INTO idempotency_keys (
+			idempotency_key, scope, request_hash, created_at, expires_at
+		) VALUES (?, ?, ?, ?, ?)
+	`
+
+	// 만료된 기록을 지운 뒤 한 번 더 시도
+	for attempt := 0; attempt < 2; attempt++ {
+		_, err := conn(ctx, r.db).ExecContext(
+			ctx, insertQuery,
+			record.Key, record.Scope, record.RequestHash, record.CreatedAt, record.ExpiresAt,
+		)
+		if err == nil {
+			return nil, nil
+		}
+
+		if !isDuplicateEntry(err) {
+			return nil, errors.Internal("INTERNAL_ERROR", "멱등성 키를 저장하는데 실패했습니다.")
+		}
+
+		existing, err := r.find(ctx, record.Key, record.Scope)
+		if err != nil {
+			return nil, err
+		}
+
+		if existing != nil && existing.ExpiresAt.After(record.CreatedAt) {
+			return existing, nil
+		}
+
+		deleteQuery := `
+			DELETE FROM idempotency_keys
+			WHERE idempotency_key = ? AND scope = ? AND expires_at <= ?
+		`
+		if _, err := conn(ctx, r.db).ExecContext(ctx, deleteQuery, record.Key, record.Scope, record.CreatedAt); err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "만료된 멱등성 키를 삭제하는데 실패했습니다.")
+		}
+	}
+
+	return nil, errors.Conflict("IDEMPOTENCY_REQUEST_IN_PROGRESS", "같은 Idempotency-Key의 요청을 처리하고 있습니다. 잠시 후 다시 시도해주세요.")
+}
+
+func (r *idempotencyRepository) find(ctx context.Context, key, scope string) (*middleware.IdempotencyRecord, error) {
+	query := `
+		SELECT idempotency_key, scope, request_hash, status_code, response_body,
+			completed_at, created_at, expires_at
+		FROM idempotency_keys
+		WHERE idempotency_key = ? AND scope = ?
+	`
+
+	var (
+		record      middleware.IdempotencyRecord
+		statusCode  sql.NullInt64
+		completedAt sql.NullTime
+	)
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, key, scope).Scan(
+		&record.Key, &record.Scope, &record.RequestHash, &statusCode, &record.ResponseBody,
+		&completedAt, &record.CreatedAt, &record.ExpiresAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "멱등성 키를 조회하는데 실패했습니다.")
+	}
+
+	record.StatusCode = int(statusCode.Int64)
+	record.Completed = completedAt.Valid
+
+	return &record, nil
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, key, scope string, statusCode int, body []byte) error {
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = ?, response_body = ?, completed_at = ?
+		WHERE idempotency_key = ? AND scope = ?
+	`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, statusCode, body, time.Now(), key, scope); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "멱등성 응답을 저장하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *idempotencyRepository) Release(ctx context.Context, key, scope string) error {
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE idempotency_key = ? AND scope = ? AND completed_at IS NULL
+	`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, key, scope); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "멱등성 키를 삭제하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+// isDuplicateEntry UNIQUE 키 중복 에러인지 확인
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *driver.MySQLError
+	return stderrors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
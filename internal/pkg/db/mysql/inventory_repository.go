@@ -0,0 +1,302 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/myramen/be/internal/app/inventory"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+type inventoryRepository struct {
+	db *sql.DB
+}
+
+func NewInventoryRepository(db *sql.DB) inventory.Repository {
+	return &inventoryRepository{db: db}
+}
+
+func (r *inventoryRepository) CreateItem(ctx context.Context, item *inventory.StockItem) error {
+	query := `
+		INSERT INTO inventory_items (
+			sku, name, quantity, low_stock_threshold, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		item.SKU, item.Name, item.Quantity, item.LowStockThreshold, item.CreatedAt, item.UpdatedAt,
+	)
+
+	if isDuplicateEntry(err) {
+		return inventory.ErrStockItemExists
+	}
+
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "재고 품목을 저장하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+// stockItemColumns 재고 품목 조회 시 사용하는 컬럼 목록 (scanStockItem과 순서가 같아야 함)
+const stockItemColumns = `
+	sku, name, quantity, low_stock_threshold, created_at, updated_at
+`
+
+// scanStockItem 조회 결과 한 행을 재고 품목으로 변환
+func scanStockItem(scanner rowScanner) (*inventory.StockItem, error) {
+	var item inventory.StockItem
+
+	if err := scanner.Scan(
+		&item.SKU, &item.Name, &item.Quantity, &item.LowStockThreshold, &item.CreatedAt, &item.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *inventoryRepository) FindItem(ctx context.Context, sku string) (*inventory.StockItem, error) {
+	query := `SELECT ` + stockItemColumns + ` FROM inventory_items WHERE sku = ?`
+
+	item, err := scanStockItem(conn(ctx, r.db).QueryRowContext(ctx, query, sku))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "재고 품목을 조회하는데 실패했습니다.")
+	}
+
+	return item, nil
+}
+
+func (r *inventoryRepository) FindItems(ctx context.Context, skus []string) ([]inventory.StockItem, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if len(skus) > 0 {
+		conditions = append(conditions, "sku IN ("+placeholders(len(skus))+")")
+		for _, sku := range skus {
+			args = append(args, sku)
+		}
+	}
+
+	query := `SELECT ` + stockItemColumns + ` FROM inventory_items` + whereClause(conditions) + ` ORDER BY sku`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "재고 품목 목록을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var items []inventory.StockItem
+	for rows.Next() {
+		item, err := scanStockItem(rows)
+		if err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "재고 품목 정보를 읽는데 실패했습니다.")
+		}
+
+		items = append(items, *item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "재고 품목 목록을 조회하는데 실패했습니다.")
+	}
+
+	return items, nil
+}
+
+func (r *inventoryRepository) UpdateItem(ctx context.Context, item *inventory.StockItem) error {
+	query := `
+		UPDATE inventory_items
+		SET name = ?, low_stock_threshold = ?, updated_at = ?
+		WHERE sku = ?
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		item.Name, item.LowStockThreshold, item.UpdatedAt, item.SKU,
+	)
+
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "재고 품목을 업데이트하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) AddQuantity(ctx context.Context, sku string, delta int, updatedAt time.Time) (bool, error) {
+	// 조건부 UPDATE 한 번으로 확인과 차감을 처리해서 동시 주문에도 0 미만이 되지 않음
+	query := `
+		UPDATE inventory_items
+		SET quantity = quantity + ?, updated_at = ?
+		WHERE sku = ? AND quantity + ? >= 0
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, delta, updatedAt, sku, delta)
+	if err != nil {
+		return false, errors.Internal("INTERNAL_ERROR", "재고 수량을 변경하는데 실패했습니다.")
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Internal("INTERNAL_ERROR", "영향받은 행 수를 확인하는데 실패했습니다.")
+	}
+
+	return rows > 0, nil
+}
+
+func (r *inventoryRepository) AddLedgerEntry(ctx context.Context, entry *inventory.LedgerEntry) error {
+	query := `
+		INSERT INTO inventory_ledger (
+			sku, delta, balance, reason, order_id, actor, note, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		entry.SKU, entry.Delta, entry.Balance, entry.Reason, nullString(entry.OrderID),
+		entry.Actor, nullString(entry.Note), entry.CreatedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "재고 변동 기록을 저장하는데 실패했습니다.")
+	}
+
+	if id, err := result.LastInsertId(); err == nil {
+		entry.ID = id
+	}
+
+	return nil
+}
+
+// ledgerColumns 재고 변동 기록 조회 시 사용하는 컬럼 목록 (scanLedgerEntry와 순서가 같아야 함)
+const ledgerColumns = `
+	id, sku, delta, balance, reason, order_id, actor, note, created_at
+`
+
+// scanLedgerEntry 조회 결과 한 행을 재고 변동 기록으로 변환
+func scanLedgerEntry(scanner rowScanner) (*inventory.LedgerEntry, error) {
+	var (
+		entry   inventory.LedgerEntry
+		orderID sql.NullString
+		note    sql.NullString
+	)
+
+	if err := scanner.Scan(
+		&entry.ID, &entry.SKU, &entry.Delta, &entry.Balance, &entry.Reason,
+		&orderID, &entry.Actor, &note, &entry.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	entry.OrderID = orderID.String
+	entry.Note = note.String
+
+	return &entry, nil
+}
+
+func (r *inventoryRepository) FindLedger(ctx context.Context, sku string, limit int) ([]inventory.LedgerEntry, error) {
+	query := `SELECT ` + ledgerColumns + ` FROM inventory_ledger WHERE sku = ? ORDER BY id DESC LIMIT ?`
+
+	return r.findLedger(ctx, query, sku, limit)
+}
+
+func (r *inventoryRepository) FindOrderLedger(ctx context.Context, orderID string) ([]inventory.LedgerEntry, error) {
+	query := `SELECT ` + ledgerColumns + ` FROM inventory_ledger WHERE order_id = ? ORDER BY id`
+
+	return r.findLedger(ctx, query, orderID)
+}
+
+func (r *inventoryRepository) findLedger(ctx context.Context, query string, args ...interface{}) ([]inventory.LedgerEntry, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "재고 변동 기록을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var entries []inventory.LedgerEntry
+	for rows.Next() {
+		entry, err := scanLedgerEntry(rows)
+		if err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "재고 변동 기록을 읽는데 실패했습니다.")
+		}
+
+		entries = append(entries, *entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "재고 변동 기록을 조회하는데 실패했습니다.")
+	}
+
+	return entries, nil
+}
+
+func (r *inventoryRepository) FindUsages(ctx context.Context, menuItemIDs []string) ([]inventory.Usage, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if len(menuItemIDs) > 0 {
+		conditions = append(conditions, "menu_item_id IN ("+placeholders(len(menuItemIDs))+")")
+		for _, menuItemID := range menuItemIDs {
+			args = append(args, menuItemID)
+		}
+	}
+
+	query := `SELECT menu_item_id, sku, quantity FROM inventory_usages` + whereClause(conditions) +
+		` ORDER BY menu_item_id, sku`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "재고 소모량을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var usages []inventory.Usage
+	for rows.Next() {
+		var usage inventory.Usage
+		if err := rows.Scan(&usage.MenuItemID, &usage.SKU, &usage.Quantity); err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "재고 소모량을 읽는데 실패했습니다.")
+		}
+
+		usages = append(usages, usage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "재고 소모량을 조회하는데 실패했습니다.")
+	}
+
+	return usages, nil
+}
+
+func (r *inventoryRepository) ReplaceUsages(ctx context.Context, menuItemID string, usages []inventory.Usage) error {
+	if _, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM inventory_usages WHERE menu_item_id = ?`, menuItemID); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "재고 소모량을 삭제하는데 실패했습니다.")
+	}
+
+	if len(usages) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(usages))
+	args := make([]interface{}, 0, len(usages)*3)
+	for _, usage := range usages {
+		values = append(values, "(?, ?, ?)")
+		args = append(args, usage.MenuItemID, usage.SKU, usage.Quantity)
+	}
+
+	query := `INSERT INTO inventory_usages (menu_item_id, sku, quantity) VALUES ` + strings.Join(values, ", ")
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, args...); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "재고 소모량을 저장하는데 실패했습니다.")
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/myramen/be/internal/app/menu"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+type menuRepository struct {
+	db *sql.DB
+}
+
+func NewMenuRepository(db *sql.DB) menu.Repository {
+	return &menuRepository{db: db}
+}
+
+func (r *menuRepository) Create(ctx context.Context, item *menu.Item) error {
+	query := `
+		INSERT INTO menu_items (
+			item_id, name, description, category, price, available, display_order, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		item.ID, item.Name, nullString(item.Description), item.Category, item.Price, item.Available,
+		item.DisplayOrder, item.CreatedAt, item.UpdatedAt,
+	)
+
+	if isDuplicateEntry(err) {
+		return menu.ErrItemExists
+	}
+
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "메뉴를 저장하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+// menuColumns 메뉴 조회 시 사용하는 컬럼 목록 (scanMenuItem과 순서가 같아야 함)
+const menuColumns = `
+	item_id, name, description, category, price, available, display_order, created_at, updated_at
+`
+
+// scanMenuItem 조회 결과 한 행을 메뉴 항목으로 변환
+func scanMenuItem(scanner rowScanner) (*menu.Item, error) {
+	var (
+		item        menu.Item
+		description sql.NullString
+	)
+
+	if err := scanner.Scan(
+		&item.ID, &item.Name, &description, &item.Category, &item.Price, &item.Available,
+		&item.DisplayOrder, &item.CreatedAt, &item.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	item.Description = description.String
+
+	return &item, nil
+}
+
+func (r *menuRepository) FindByID(ctx context.Context, itemID string) (*menu.Item, error) {
+	query := `SELECT ` + menuColumns + ` FROM menu_items WHERE item_id = ?`
+
+	item, err := scanMenuItem(conn(ctx, r.db).QueryRowContext(ctx, query, itemID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "메뉴를 조회하는데 실패했습니다.")
+	}
+
+	return item, nil
+}
+
+func (r *menuRepository) FindAll(ctx context.Context, filter menu.Filter) ([]menu.Item, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if filter.Category != "" {
+		conditions = append(conditions, "category = ?")
+		args = append(args, filter.Category)
+	}
+
+	if filter.AvailableOnly {
+		conditions = append(conditions, "available = TRUE")
+	}
+
+	query := `SELECT ` + menuColumns + ` FROM menu_items` + whereClause(conditions) +
+		` ORDER BY display_order, item_id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "메뉴 목록을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var items []menu.Item
+	for rows.Next() {
+		item, err := scanMenuItem(rows)
+		if err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "메뉴 정보를 읽는데 실패했습니다.")
+		}
+
+		items = append(items, *item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "메뉴 목록을 조회하는데 실패했습니다.")
+	}
+
+	return items, nil
+}
+
+func (r *menuRepository) Update(ctx context.Context, item *menu.Item) error {
+	query := `
+		UPDATE menu_items
+		SET name = ?, description = ?, category = ?, price = ?, available = ?, display_order = ?, updated_at = ?
+		WHERE item_id = ?
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		item.Name, nullString(item.Description), item.Category, item.Price, item.Available,
+		item.DisplayOrder, item.UpdatedAt, item.ID,
+	)
+
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "메뉴를 업데이트하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *menuRepository) Delete(ctx context.Context, itemID string) error {
+	query := `DELETE FROM menu_items WHERE item_id = ?`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, itemID); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "메뉴를 삭제하는데 실패했습니다.")
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/myramen/be/internal/app/order"
+	"github.com/myramen/be/internal/pkg/encryption"
+)
+
+// IndexOrderClaimKeys claim_key가 없는 주문(계정 연결 기능 이전 주문)의 claim_key를 계산해서 저장
+// 암호화된 계좌번호는 복호화해서 계산한다. batchSize 행씩 처리하고 변경한 행 수를 반환하며,
+// 중단되어도 다시 실행하면 이어서 처리한다. all이 true이면 모든 주문을 다시 계산한다(ORDER_CLAIM_KEY 교체 시).
+func IndexOrderClaimKeys(ctx context.Context, db *sql.DB, keyring *encryption.Keyring, index *encryption.BlindIndex, batchSize int, all bool) (int, error) {
+	updated := 0
+	var lastID int64
+
+	condition := "claim_key IS NULL"
+	if all {
+		condition = "TRUE"
+	}
+
+	for {
+		rows, err := db.QueryContext(ctx, `
+			SELECT id, name, account_number, account_key_id
+			FROM orders
+			WHERE id > ? AND `+condition+`
+			ORDER BY id
+			LIMIT ?
+		`, lastID, batchSize)
+		if err != nil {
+			return updated, fmt.Errorf("select orders: %w", err)
+		}
+
+		type claimRow struct {
+			id            int64
+			name          string
+			accountNumber string
+			keyID         sql.NullString
+		}
+
+		var batch []claimRow
+		for rows.Next() {
+			var row claimRow
+			if err := rows.Scan(&row.id, &row.name, &row.accountNumber, &row.keyID); err != nil {
+				rows.Close()
+				return updated, fmt.Errorf("scan order: %w", err)
+			}
+			batch = append(batch, row)
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return updated, fmt.Errorf("iterate orders: %w", err)
+		}
+
+		if len(batch) == 0 {
+			return updated, nil
+		}
+
+		for _, row := range batch {
+			lastID = row.id
+
+			accountNumber := row.accountNumber
+			if row.keyID.Valid {
+				accountNumber, err = keyring.Decrypt(row.accountNumber, row.keyID.String)
+				if err != nil {
+					return updated, fmt.Errorf("decrypt order %d: %w", row.id, err)
+				}
+			}
+
+			result, err := db.ExecContext(ctx, `UPDATE orders SET claim_key = ? WHERE id = ?`,
+				order.ClaimKey(index, row.name, accountNumber), row.id)
+			if err != nil {
+				return updated, fmt.Errorf("update order %d: %w", row.id, err)
+			}
+
+			if n, err := result.RowsAffected(); err == nil {
+				updated += int(n)
+			}
+		}
+	}
+}
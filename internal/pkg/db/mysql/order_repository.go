@@ -4,17 +4,24 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"strings"
+	"time"
 
 	"github.com/myramen/be/internal/app/order"
+	"github.com/myramen/be/internal/app/pricing"
+	"github.com/myramen/be/internal/pkg/encryption"
 	"github.com/myramen/be/internal/pkg/utils/errors"
 )
 
 type orderRepository struct {
-	db *sql.DB
+	db      *sql.DB
+	keyring *encryption.Keyring
 }
 
-func NewOrderRepository(db *sql.DB) order.Repository {
-	return &orderRepository{db: db}
+// NewOrderRepository 주문 리포지토리 생성
+// keyring이 활성화되어 있으면 계좌번호를 암호화해서 저장한다.
+func NewOrderRepository(db *sql.DB, keyring *encryption.Keyring) order.Repository {
+	return &orderRepository{db: db, keyring: keyring}
 }
 
 func (r *orderRepository) Create(ctx context.Context, order *order.Order) error {
@@ -24,6 +31,15 @@ func (r *orderRepository) Create(ctx context.Context, order *order.Order) error
 		return errors.Internal("INTERNAL_ERROR", "주문 옵션을 JSON으로 변환하는데 실패했습니다.")
 	}
 
+	// 가격 명세 JSON으로 변환
+	var priceBreakdownJSON []byte
+	if order.PriceBreakdown != nil {
+		priceBreakdownJSON, err = json.Marshal(order.PriceBreakdown)
+		if err != nil {
+			return errors.Internal("INTERNAL_ERROR", "가격 명세를 JSON으로 변환하는데 실패했습니다.")
+		}
+	}
+
 	// 적용된 쿠폰 JSON으로 변환
 	var appliedCouponJSON []byte
 	if order.AppliedCoupon != nil {
@@ -42,18 +58,26 @@ func (r *orderRepository) Create(ctx context.Context, order *order.Order) error
 		}
 	}
 
+	// 계좌번호 암호화
+	accountNumber, accountKeyID, err := encryptAccountNumber(r.keyring, order.AccountNumber)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "계좌번호를 암호화하는데 실패했습니다.")
+	}
+
 	query := `
 		INSERT INTO orders (
-			order_id, name, account_number, quantity, spicy_level, 
-			delivery_option, options, total_price, status, 
+			order_id, customer_id, name, account_number, account_key_id, claim_key, quantity,
+			delivery_option, options, options_price, total_price, price_breakdown, status, lookup_token_hash,
 			applied_coupon, new_coupon, created_at, updated_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	_, err = r.db.ExecContext(
+	_, err = conn(ctx, r.db).ExecContext(
 		ctx, query,
-		order.OrderID, order.Name, order.AccountNumber, order.Quantity, order.SpicyLevel,
-		order.DeliveryOption, optionsJSON, order.TotalPrice, order.Status,
+		order.OrderID, nullInt64(order.CustomerID), order.Name, accountNumber, accountKeyID,
+		nullString(order.ClaimKey), order.Quantity,
+		order.DeliveryOption, optionsJSON, order.OptionsPrice, order.TotalPrice, priceBreakdownJSON,
+		order.Status, order.LookupTokenHash,
 		appliedCouponJSON, newCouponJSON, order.CreatedAt, order.UpdatedAt,
 	)
 
@@ -61,46 +85,173 @@ func (r *orderRepository) Create(ctx context.Context, order *order.Order) error
 		return errors.Internal("INTERNAL_ERROR", "주문을 저장하는데 실패했습니다.")
 	}
 
+	return r.createItems(ctx, order.OrderID, order.Items)
+}
+
+// createItems 주문 항목 저장 (주문과 같은 트랜잭션에서 호출)
+func (r *orderRepository) createItems(ctx context.Context, orderID string, items []order.OrderItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(items))
+	args := make([]interface{}, 0, len(items)*10)
+
+	for i, item := range items {
+		toppingsJSON, err := json.Marshal(item.Toppings)
+		if err != nil {
+			return errors.Internal("INTERNAL_ERROR", "토핑을 JSON으로 변환하는데 실패했습니다.")
+		}
+
+		values = append(values, "("+placeholders(10)+")")
+		args = append(args,
+			orderID, i+1, item.MenuItemID, item.ItemName, item.Category, item.UnitPrice,
+			item.Quantity, item.SpicyLevel, toppingsJSON, item.LinePrice,
+		)
+	}
+
+	query := `
+		INSERT INTO order_items (
+			order_id, line_no, menu_item_id, item_name, category, unit_price,
+			quantity, spicy_level, toppings, line_price
+		) VALUES ` + strings.Join(values, ", ")
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, args...); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "주문 항목을 저장하는데 실패했습니다.")
+	}
+
 	return nil
 }
 
-func (r *orderRepository) FindByID(ctx context.Context, orderID string) (*order.Order, error) {
+// attachItems 주문 목록의 항목을 한 번에 조회해서 채움
+func (r *orderRepository) attachItems(ctx context.Context, orders []order.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	orderIDs := make([]interface{}, len(orders))
+	for i := range orders {
+		orderIDs[i] = orders[i].OrderID
+	}
+
 	query := `
-		SELECT 
-			order_id, name, account_number, quantity, spicy_level, 
-			delivery_option, options, total_price, status, 
-			applied_coupon, new_coupon, created_at, updated_at
-		FROM orders 
-		WHERE order_id = ?
+		SELECT order_id, menu_item_id, item_name, category, unit_price, quantity, spicy_level, toppings, line_price
+		FROM order_items
+		WHERE order_id IN (` + placeholders(len(orderIDs)) + `)
+		ORDER BY order_id, line_no
 	`
 
-	var (
-		orderResult       order.Order
-		optionsJSON       []byte
-		appliedCouponJSON sql.NullString
-		newCouponJSON     sql.NullString
-	)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, orderIDs...)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "주문 항목을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	itemsByOrder := make(map[string][]order.OrderItem, len(orders))
+
+	for rows.Next() {
+		var (
+			orderID      string
+			item         order.OrderItem
+			toppingsJSON []byte
+		)
+
+		if err := rows.Scan(
+			&orderID, &item.MenuItemID, &item.ItemName, &item.Category, &item.UnitPrice, &item.Quantity,
+			&item.SpicyLevel, &toppingsJSON, &item.LinePrice,
+		); err != nil {
+			return errors.Internal("INTERNAL_ERROR", "주문 항목을 파싱하는데 실패했습니다.")
+		}
+
+		if err := json.Unmarshal(toppingsJSON, &item.Toppings); err != nil {
+			return errors.Internal("INTERNAL_ERROR", "토핑을 파싱하는데 실패했습니다.")
+		}
 
-	err := r.db.QueryRowContext(ctx, query, orderID).Scan(
-		&orderResult.OrderID, &orderResult.Name, &orderResult.AccountNumber,
-		&orderResult.Quantity, &orderResult.SpicyLevel, &orderResult.DeliveryOption,
-		&optionsJSON, &orderResult.TotalPrice, &orderResult.Status,
-		&appliedCouponJSON, &newCouponJSON, &orderResult.CreatedAt, &orderResult.UpdatedAt,
+		itemsByOrder[orderID] = append(itemsByOrder[orderID], item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "주문 항목을 처리하는데 실패했습니다.")
+	}
+
+	for i := range orders {
+		orders[i].Items = itemsByOrder[orders[i].OrderID]
+		if orders[i].Items == nil {
+			orders[i].Items = []order.OrderItem{}
+		}
+	}
+
+	return nil
+}
+
+// orderColumns 주문 조회 시 사용하는 컬럼 목록 (scanOrder와 순서가 같아야 함)
+const orderColumns = `
+	id, order_id, customer_id, name, account_number, account_key_id, quantity,
+	delivery_option, options, options_price, total_price, price_breakdown, status, lookup_token_hash,
+	applied_coupon, new_coupon, cancel_reason, cancelled_at,
+	refund_amount, refund_note, refunded_at, created_at, updated_at
+`
+
+// rowScanner sql.Row와 sql.Rows 공통 인터페이스
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanOrder 조회 결과 한 행을 주문으로 변환 (계좌번호 복호화 포함)
+func (r *orderRepository) scanOrder(scanner rowScanner) (*order.Order, error) {
+	var (
+		orderResult        order.Order
+		customerID         sql.NullInt64
+		accountKeyID       sql.NullString
+		optionsPrice       sql.NullInt64
+		optionsJSON        []byte
+		priceBreakdownJSON []byte
+		appliedCouponJSON  sql.NullString
+		newCouponJSON      sql.NullString
+		lookupTokenHash    sql.NullString
+		cancelReason       sql.NullString
+		cancelledAt        sql.NullTime
+		refundAmount       sql.NullInt64
+		refundNote         sql.NullString
+		refundedAt         sql.NullTime
 	)
 
-	if err == sql.ErrNoRows {
-		return nil, nil
+	if err := scanner.Scan(
+		&orderResult.ID, &orderResult.OrderID, &customerID, &orderResult.Name, &orderResult.AccountNumber, &accountKeyID,
+		&orderResult.Quantity, &orderResult.DeliveryOption, &optionsJSON, &optionsPrice, &orderResult.TotalPrice, &priceBreakdownJSON,
+		&orderResult.Status, &lookupTokenHash,
+		&appliedCouponJSON, &newCouponJSON, &cancelReason, &cancelledAt,
+		&refundAmount, &refundNote, &refundedAt, &orderResult.CreatedAt, &orderResult.UpdatedAt,
+	); err != nil {
+		return nil, err
 	}
 
-	if err != nil {
-		return nil, errors.Internal("INTERNAL_ERROR", "주문을 조회하는데 실패했습니다.")
+	// 계좌번호 복호화 (키 ID가 없으면 암호화 이전에 저장된 평문)
+	if accountKeyID.Valid {
+		accountNumber, err := r.keyring.Decrypt(orderResult.AccountNumber, accountKeyID.String)
+		if err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "계좌번호를 복호화하는데 실패했습니다.")
+		}
+		orderResult.AccountNumber = accountNumber
 	}
 
+	orderResult.CustomerID = customerID.Int64
+	orderResult.OptionsPrice = int(optionsPrice.Int64)
+
 	// Options 파싱
 	if err := json.Unmarshal(optionsJSON, &orderResult.Options); err != nil {
 		return nil, errors.Internal("INTERNAL_ERROR", "주문 옵션을 파싱하는데 실패했습니다.")
 	}
 
+	// 가격 명세 파싱 (가격 명세 도입 이전 주문은 없음)
+	if len(priceBreakdownJSON) > 0 {
+		var breakdown pricing.Breakdown
+		if err := json.Unmarshal(priceBreakdownJSON, &breakdown); err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "가격 명세를 파싱하는데 실패했습니다.")
+		}
+		orderResult.PriceBreakdown = &breakdown
+	}
+
 	// 적용된 쿠폰 파싱
 	if appliedCouponJSON.Valid && appliedCouponJSON.String != "" {
 		var appliedCoupon order.Coupon
@@ -119,85 +270,231 @@ func (r *orderRepository) FindByID(ctx context.Context, orderID string) (*order.
 		orderResult.NewCoupon = &newCoupon
 	}
 
+	orderResult.LookupTokenHash = lookupTokenHash.String
+
+	// 취소 정보
+	orderResult.CancelReason = cancelReason.String
+	if cancelledAt.Valid {
+		orderResult.CancelledAt = &cancelledAt.Time
+	}
+
+	// 환불 정보
+	if refundedAt.Valid {
+		orderResult.Refund = &order.Refund{
+			Amount:     int(refundAmount.Int64),
+			Note:       refundNote.String,
+			RefundedAt: refundedAt.Time,
+		}
+	}
+
 	return &orderResult, nil
 }
 
-func (r *orderRepository) FindAll(ctx context.Context) ([]order.Order, error) {
-	query := `
-		SELECT 
-			order_id, name, account_number, quantity, spicy_level, 
-			delivery_option, options, total_price, status, 
-			applied_coupon, new_coupon, created_at, updated_at
-		FROM orders
-		ORDER BY created_at DESC
-	`
+func (r *orderRepository) FindByID(ctx context.Context, orderID string) (*order.Order, error) {
+	query := `SELECT ` + orderColumns + ` FROM orders WHERE order_id = ?`
+
+	orderResult, err := r.scanOrder(conn(ctx, r.db).QueryRowContext(ctx, query, orderID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 
-	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
+		if _, ok := err.(errors.CustomError); ok {
+			return nil, err
+		}
 		return nil, errors.Internal("INTERNAL_ERROR", "주문을 조회하는데 실패했습니다.")
 	}
-	defer rows.Close()
 
-	var orders []order.Order
+	orders := []order.Order{*orderResult}
+	if err := r.attachItems(ctx, orders); err != nil {
+		return nil, err
+	}
 
-	for rows.Next() {
-		var (
-			orderItem         order.Order
-			optionsJSON       []byte
-			appliedCouponJSON sql.NullString
-			newCouponJSON     sql.NullString
-		)
+	return &orders[0], nil
+}
 
-		if err := rows.Scan(
-			&orderItem.OrderID, &orderItem.Name, &orderItem.AccountNumber,
-			&orderItem.Quantity, &orderItem.SpicyLevel, &orderItem.DeliveryOption,
-			&optionsJSON, &orderItem.TotalPrice, &orderItem.Status,
-			&appliedCouponJSON, &newCouponJSON, &orderItem.CreatedAt, &orderItem.UpdatedAt,
-		); err != nil {
-			return nil, errors.Internal("INTERNAL_ERROR", "주문 정보를 파싱하는데 실패했습니다.")
-		}
+func (r *orderRepository) FindAll(ctx context.Context, filter order.ListFilter) ([]order.Order, error) {
+	where, args := orderListConditions(filter)
 
-		// Options 파싱
-		if err := json.Unmarshal(optionsJSON, &orderItem.Options); err != nil {
-			return nil, errors.Internal("INTERNAL_ERROR", "주문 옵션을 파싱하는데 실패했습니다.")
+	// 커서 이후 조건 (정렬 키가 같으면 id로 순서 결정)
+	sortColumn, desc := orderSortColumn(filter.Sort)
+	if filter.After != nil {
+		var sortValue interface{} = filter.After.CreatedAt
+		if sortColumn == "total_price" {
+			sortValue = filter.After.TotalPrice
 		}
 
-		// 적용된 쿠폰 파싱
-		if appliedCouponJSON.Valid && appliedCouponJSON.String != "" {
-			var appliedCoupon order.Coupon
-			if err := json.Unmarshal([]byte(appliedCouponJSON.String), &appliedCoupon); err != nil {
-				return nil, errors.Internal("INTERNAL_ERROR", "적용된 쿠폰을 파싱하는데 실패했습니다.")
-			}
-			orderItem.AppliedCoupon = &appliedCoupon
+		op := ">"
+		if desc {
+			op = "<"
 		}
 
-		// 새 쿠폰 파싱
-		if newCouponJSON.Valid && newCouponJSON.String != "" {
-			var newCoupon order.Coupon
-			if err := json.Unmarshal([]byte(newCouponJSON.String), &newCoupon); err != nil {
-				return nil, errors.Internal("INTERNAL_ERROR", "새 쿠폰을 파싱하는데 실패했습니다.")
+		where = append(where, "("+sortColumn+" "+op+" ? OR ("+sortColumn+" = ? AND id "+op+" ?))")
+		args = append(args, sortValue, sortValue, filter.After.ID)
+	}
+
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+
+	query := `SELECT ` + orderColumns + ` FROM orders` + whereClause(where) +
+		` ORDER BY ` + sortColumn + ` ` + direction + `, id ` + direction + ` LIMIT ?`
+	args = append(args, filter.Limit)
+
+	return r.findOrders(ctx, query, args...)
+}
+
+// findOrders 여러 주문 조회 (주문 항목 포함)
+func (r *orderRepository) findOrders(ctx context.Context, query string, args ...interface{}) ([]order.Order, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "주문을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var orders []order.Order
+
+	for rows.Next() {
+		orderItem, err := r.scanOrder(rows)
+		if err != nil {
+			if _, ok := err.(errors.CustomError); ok {
+				return nil, err
 			}
-			orderItem.NewCoupon = &newCoupon
+			return nil, errors.Internal("INTERNAL_ERROR", "주문 정보를 파싱하는데 실패했습니다.")
 		}
 
-		orders = append(orders, orderItem)
+		orders = append(orders, *orderItem)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, errors.Internal("INTERNAL_ERROR", "주문 데이터를 처리하는데 실패했습니다.")
 	}
 
+	if err := r.attachItems(ctx, orders); err != nil {
+		return nil, err
+	}
+
 	return orders, nil
 }
 
-func (r *orderRepository) UpdateStatus(ctx context.Context, orderID string, status string) error {
+func (r *orderRepository) Claim(ctx context.Context, claimKey string, customerID int64) ([]order.Order, error) {
+	// 잠금 읽기로 연결할 주문을 확정한 뒤 같은 조건으로 갱신 (동시에 연결해도 한 계정에만 연결됨)
+	query := `SELECT ` + orderColumns + ` FROM orders WHERE claim_key = ? AND customer_id IS NULL ORDER BY id FOR UPDATE`
+
+	orders, err := r.findOrders(ctx, query, claimKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(orders) == 0 {
+		return orders, nil
+	}
+
+	ids := make([]interface{}, 0, len(orders)+1)
+	ids = append(ids, customerID)
+	for i := range orders {
+		ids = append(ids, orders[i].ID)
+		orders[i].CustomerID = customerID
+	}
+
+	query = `UPDATE orders SET customer_id = ? WHERE id IN (` + placeholders(len(orders)) + `)`
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, ids...); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "주문을 고객에게 연결하는데 실패했습니다.")
+	}
+
+	return orders, nil
+}
+
+func (r *orderRepository) Count(ctx context.Context, filter order.ListFilter) (int, error) {
+	where, args := orderListConditions(filter)
+
+	var count int
+	query := `SELECT COUNT(*) FROM orders` + whereClause(where)
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, errors.Internal("INTERNAL_ERROR", "주문 수를 조회하는데 실패했습니다.")
+	}
+
+	return count, nil
+}
+
+// orderListConditions 목록 조회 조건을 WHERE 절과 인자로 변환
+func orderListConditions(filter order.ListFilter) ([]string, []interface{}) {
+	var (
+		where []string
+		args  []interface{}
+	)
+
+	if filter.CustomerID != 0 {
+		where = append(where, "customer_id = ?")
+		args = append(args, filter.CustomerID)
+	}
+
+	if len(filter.Statuses) > 0 {
+		where = append(where, "status IN ("+placeholders(len(filter.Statuses))+")")
+		for _, status := range filter.Statuses {
+			args = append(args, status)
+		}
+	}
+
+	if filter.DeliveryOption != "" {
+		where = append(where, "delivery_option = ?")
+		args = append(args, filter.DeliveryOption)
+	}
+
+	if filter.CreatedFrom != nil {
+		where = append(where, "created_at >= ?")
+		args = append(args, *filter.CreatedFrom)
+	}
+
+	if filter.CreatedTo != nil {
+		where = append(where, "created_at < ?")
+		args = append(args, *filter.CreatedTo)
+	}
+
+	if filter.NamePrefix != "" {
+		where = append(where, "name LIKE ?")
+		args = append(args, escapeLike(filter.NamePrefix)+"%")
+	}
+
+	if filter.CouponUsed != nil {
+		if *filter.CouponUsed {
+			where = append(where, "applied_coupon IS NOT NULL")
+		} else {
+			where = append(where, "applied_coupon IS NULL")
+		}
+	}
+
+	if filter.MinTotalPrice != nil {
+		where = append(where, "total_price >= ?")
+		args = append(args, *filter.MinTotalPrice)
+	}
+
+	return where, args
+}
+
+// orderSortColumn 정렬 기준을 컬럼명과 내림차순 여부로 변환
+func orderSortColumn(sort string) (string, bool) {
+	switch sort {
+	case order.SortCreatedAtAsc:
+		return "created_at", false
+	case order.SortTotalPriceDesc:
+		return "total_price", true
+	case order.SortTotalPriceAsc:
+		return "total_price", false
+	default:
+		return "created_at", true
+	}
+}
+
+func (r *orderRepository) UpdateStatus(ctx context.Context, orderID string, fromStatus string, status string) error {
 	query := `
 		UPDATE orders 
 		SET status = ?, updated_at = NOW() 
-		WHERE order_id = ?
+		WHERE order_id = ? AND status = ?
 	`
 
-	result, err := r.db.ExecContext(ctx, query, status, orderID)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, status, orderID, fromStatus)
 	if err != nil {
 		return errors.Internal("INTERNAL_ERROR", "주문 상태를 업데이트하는데 실패했습니다.")
 	}
@@ -208,16 +505,125 @@ func (r *orderRepository) UpdateStatus(ctx context.Context, orderID string, stat
 	}
 
 	if rows == 0 {
-		return errors.NotFound("NOT_FOUND", "해당 주문을 찾을 수 없습니다.")
+		return errors.Conflict("ORDER_STATE_CHANGED", "주문 상태가 변경되었습니다. 다시 시도해주세요.")
+	}
+
+	return nil
+}
+
+func (r *orderRepository) Cancel(ctx context.Context, orderID string, fromStatus string, reason string, cancelledAt time.Time) error {
+	query := `
+		UPDATE orders
+		SET status = ?, cancel_reason = ?, cancelled_at = ?, updated_at = NOW()
+		WHERE order_id = ? AND status = ?
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, order.StatusCancelled, reason, cancelledAt, orderID, fromStatus)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "주문을 취소하는데 실패했습니다.")
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "영향받은 행 수를 확인하는데 실패했습니다.")
+	}
+
+	if rows == 0 {
+		return errors.Conflict("ORDER_STATE_CHANGED", "주문 상태가 변경되어 취소할 수 없습니다. 다시 시도해주세요.")
 	}
 
 	return nil
 }
 
+func (r *orderRepository) Refund(ctx context.Context, orderID string, refund *order.Refund) error {
+	query := `
+		UPDATE orders
+		SET status = ?, refund_amount = ?, refund_note = ?, refunded_at = ?, updated_at = NOW()
+		WHERE order_id = ? AND status = ?
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		order.StatusRefunded, refund.Amount, refund.Note, refund.RefundedAt, orderID, order.StatusCancelled,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "환불 정보를 저장하는데 실패했습니다.")
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "영향받은 행 수를 확인하는데 실패했습니다.")
+	}
+
+	if rows == 0 {
+		return errors.Conflict("ORDER_STATE_CHANGED", "주문 상태가 변경되어 환불할 수 없습니다. 다시 시도해주세요.")
+	}
+
+	return nil
+}
+
+func (r *orderRepository) AddStatusHistory(ctx context.Context, orderID string, change *order.StatusChange) error {
+	query := `
+		INSERT INTO order_status_history (
+			order_id, previous_status, new_status, actor, note, created_at
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		orderID, nullString(change.PreviousStatus), change.NewStatus, change.Actor,
+		nullString(change.Note), change.CreatedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "주문 상태 이력을 저장하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *orderRepository) FindStatusHistory(ctx context.Context, orderID string) ([]order.StatusChange, error) {
+	query := `
+		SELECT previous_status, new_status, actor, note, created_at
+		FROM order_status_history
+		WHERE order_id = ?
+		ORDER BY id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "주문 상태 이력을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	history := []order.StatusChange{}
+
+	for rows.Next() {
+		var (
+			change         order.StatusChange
+			previousStatus sql.NullString
+			note           sql.NullString
+		)
+
+		if err := rows.Scan(&previousStatus, &change.NewStatus, &change.Actor, &note, &change.CreatedAt); err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "주문 상태 이력을 파싱하는데 실패했습니다.")
+		}
+
+		change.PreviousStatus = previousStatus.String
+		change.Note = note.String
+		history = append(history, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "주문 상태 이력을 처리하는데 실패했습니다.")
+	}
+
+	return history, nil
+}
+
 func (r *orderRepository) Delete(ctx context.Context, orderID string) error {
 	query := "DELETE FROM orders WHERE order_id = ?"
 
-	result, err := r.db.ExecContext(ctx, query, orderID)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, orderID)
 	if err != nil {
 		return errors.Internal("INTERNAL_ERROR", "주문을 삭제하는데 실패했습니다.")
 	}
@@ -231,5 +637,23 @@ func (r *orderRepository) Delete(ctx context.Context, orderID string) error {
 		return errors.NotFound("NOT_FOUND", "해당 주문을 찾을 수 없습니다.")
 	}
 
+	if _, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM order_items WHERE order_id = ?", orderID); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "주문 항목을 삭제하는데 실패했습니다.")
+	}
+
 	return nil
 }
+
+// encryptAccountNumber 키링이 활성화되어 있으면 계좌번호를 암호화 (비활성화 시 평문, 키 ID NULL)
+func encryptAccountNumber(keyring *encryption.Keyring, accountNumber string) (string, sql.NullString, error) {
+	if !keyring.Enabled() {
+		return accountNumber, sql.NullString{}, nil
+	}
+
+	ciphertext, keyID, err := keyring.Encrypt(accountNumber)
+	if err != nil {
+		return "", sql.NullString{}, err
+	}
+
+	return ciphertext, sql.NullString{String: keyID, Valid: true}, nil
+}
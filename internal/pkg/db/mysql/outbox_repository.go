@@ -0,0 +1,124 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/event"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// maxOutboxErrorLength last_error 컬럼 길이
+const maxOutboxErrorLength = 255
+
+type outboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) event.Store {
+	return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) Append(ctx context.Context, e *event.Event) error {
+	query := `
+		INSERT INTO outbox (
+			event_id, event_type, aggregate_type, aggregate_id, payload, occurred_at, available_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		e.ID, e.Type, e.AggregateType, e.AggregateID, []byte(e.Payload), e.OccurredAt, e.OccurredAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "이벤트를 기록하는데 실패했습니다.")
+	}
+
+	seq, err := result.LastInsertId()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "이벤트를 기록하는데 실패했습니다.")
+	}
+	e.Seq = seq
+
+	return nil
+}
+
+func (r *outboxRepository) FetchPending(ctx context.Context, now time.Time, limit int) ([]event.Event, error) {
+	// 같은 집합체에 더 앞선 미발행 이벤트가 있으면 제외해 집합체 단위 순서를 보장
+	query := `
+		SELECT o.seq, o.event_id, o.event_type, o.aggregate_type, o.aggregate_id, o.payload,
+			o.occurred_at, o.attempts
+		FROM outbox o
+		WHERE o.published_at IS NULL
+			AND o.available_at <= ?
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox p
+				WHERE p.aggregate_type = o.aggregate_type
+					AND p.aggregate_id = o.aggregate_id
+					AND p.published_at IS NULL
+					AND p.seq < o.seq
+			)
+		ORDER BY o.seq
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "발행할 이벤트를 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var events []event.Event
+	for rows.Next() {
+		var (
+			e       event.Event
+			payload []byte
+		)
+
+		if err := rows.Scan(
+			&e.Seq, &e.ID, &e.Type, &e.AggregateType, &e.AggregateID, &payload,
+			&e.OccurredAt, &e.Attempts,
+		); err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "이벤트 정보를 읽는데 실패했습니다.")
+		}
+		e.Payload = payload
+
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "발행할 이벤트를 조회하는데 실패했습니다.")
+	}
+
+	return events, nil
+}
+
+func (r *outboxRepository) MarkPublished(ctx context.Context, seq int64, publishedAt time.Time) error {
+	query := `UPDATE outbox SET published_at = ?, last_error = NULL WHERE seq = ?`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, publishedAt, seq); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "이벤트 발행 상태를 저장하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) MarkFailed(ctx context.Context, seq int64, lastError string, availableAt time.Time) error {
+	query := `
+		UPDATE outbox
+		SET attempts = attempts + 1, last_error = ?, available_at = ?
+		WHERE seq = ?
+	`
+
+	if runes := []rune(lastError); len(runes) > maxOutboxErrorLength {
+		lastError = string(runes[:maxOutboxErrorLength])
+	}
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, lastError, availableAt, seq); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "이벤트 발행 실패를 기록하는데 실패했습니다.")
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/myramen/be/internal/app/pricing"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+type pricingRuleRepository struct {
+	db *sql.DB
+}
+
+func NewPricingRuleRepository(db *sql.DB) pricing.Repository {
+	return &pricingRuleRepository{db: db}
+}
+
+func (r *pricingRuleRepository) Create(ctx context.Context, rule *pricing.Rule) error {
+	paramsJSON, err := json.Marshal(rule.Params)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "가격 규칙 설정을 JSON으로 변환하는데 실패했습니다.")
+	}
+
+	query := `
+		INSERT INTO pricing_rules (
+			name, type, params, active, priority, starts_at, ends_at, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		rule.Name, rule.Type, paramsJSON, rule.Active, rule.Priority, rule.StartsAt, rule.EndsAt,
+		rule.CreatedAt, rule.UpdatedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "가격 규칙을 저장하는데 실패했습니다.")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "가격 규칙 ID를 확인하는데 실패했습니다.")
+	}
+	rule.ID = id
+
+	return nil
+}
+
+// pricingRuleColumns 가격 규칙 조회 시 사용하는 컬럼 목록 (scanPricingRule과 순서가 같아야 함)
+const pricingRuleColumns = `
+	id, name, type, params, active, priority, starts_at, ends_at, created_at, updated_at
+`
+
+// scanPricingRule 조회 결과 한 행을 가격 규칙으로 변환
+func scanPricingRule(scanner rowScanner) (*pricing.Rule, error) {
+	var (
+		rule       pricing.Rule
+		paramsJSON []byte
+		startsAt   sql.NullTime
+		endsAt     sql.NullTime
+	)
+
+	if err := scanner.Scan(
+		&rule.ID, &rule.Name, &rule.Type, &paramsJSON, &rule.Active, &rule.Priority,
+		&startsAt, &endsAt, &rule.CreatedAt, &rule.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(paramsJSON, &rule.Params); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "가격 규칙 설정을 파싱하는데 실패했습니다.")
+	}
+
+	if startsAt.Valid {
+		rule.StartsAt = &startsAt.Time
+	}
+
+	if endsAt.Valid {
+		rule.EndsAt = &endsAt.Time
+	}
+
+	return &rule, nil
+}
+
+func (r *pricingRuleRepository) FindByID(ctx context.Context, id int64) (*pricing.Rule, error) {
+	query := `SELECT ` + pricingRuleColumns + ` FROM pricing_rules WHERE id = ?`
+
+	rule, err := scanPricingRule(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		if _, ok := err.(errors.CustomError); ok {
+			return nil, err
+		}
+		return nil, errors.Internal("INTERNAL_ERROR", "가격 규칙을 조회하는데 실패했습니다.")
+	}
+
+	return rule, nil
+}
+
+func (r *pricingRuleRepository) FindAll(ctx context.Context, activeOnly bool) ([]pricing.Rule, error) {
+	var conditions []string
+	if activeOnly {
+		conditions = append(conditions, "active = TRUE")
+	}
+
+	query := `SELECT ` + pricingRuleColumns + ` FROM pricing_rules` + whereClause(conditions) +
+		` ORDER BY priority, id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "가격 규칙 목록을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var rules []pricing.Rule
+	for rows.Next() {
+		rule, err := scanPricingRule(rows)
+		if err != nil {
+			if _, ok := err.(errors.CustomError); ok {
+				return nil, err
+			}
+			return nil, errors.Internal("INTERNAL_ERROR", "가격 규칙 정보를 읽는데 실패했습니다.")
+		}
+
+		rules = append(rules, *rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "가격 규칙 목록을 조회하는데 실패했습니다.")
+	}
+
+	return rules, nil
+}
+
+func (r *pricingRuleRepository) Update(ctx context.Context, rule *pricing.Rule) error {
+	paramsJSON, err := json.Marshal(rule.Params)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "가격 규칙 설정을 JSON으로 변환하는데 실패했습니다.")
+	}
+
+	query := `
+		UPDATE pricing_rules
+		SET name = ?, type = ?, params = ?, active = ?, priority = ?, starts_at = ?, ends_at = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	_, err = conn(ctx, r.db).ExecContext(
+		ctx, query,
+		rule.Name, rule.Type, paramsJSON, rule.Active, rule.Priority, rule.StartsAt, rule.EndsAt,
+		rule.UpdatedAt, rule.ID,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "가격 규칙을 업데이트하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *pricingRuleRepository) Delete(ctx context.Context, id int64) error {
+	if _, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM pricing_rules WHERE id = ?`, id); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "가격 규칙을 삭제하는데 실패했습니다.")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package mysql
+
+import (
+	"database/sql"
+	"strings"
+)
+
+// whereClause 조건 목록을 AND로 연결한 WHERE 절 (조건이 없으면 빈 문자열)
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+// placeholders IN 절에 사용할 "?, ?, ?" 문자열
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// escapeLike LIKE 패턴의 특수 문자 이스케이프
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// nullString 빈 문자열을 NULL로 저장
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// nullInt64 0을 NULL로 저장
+func nullInt64(n int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: n, Valid: n != 0}
+}
@@ -0,0 +1,67 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// txKey 컨텍스트에 진행 중인 트랜잭션을 저장하는 키
+type txKey struct{}
+
+// executor sql.DB와 sql.Tx 공통 인터페이스
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// conn 컨텍스트에 트랜잭션이 있으면 트랜잭션을, 없으면 DB 커넥션 풀을 반환
+func conn(ctx context.Context, db *sql.DB) executor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// Transactor MySQL 트랜잭션 관리자
+type Transactor struct {
+	db *sql.DB
+}
+
+// NewTransactor 트랜잭션 관리자 생성
+func NewTransactor(db *sql.DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+// WithinTx fn을 하나의 트랜잭션 안에서 실행
+func (t *Transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	// 이미 트랜잭션이 진행 중이면 합류
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "트랜잭션을 시작하는데 실패했습니다.")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "트랜잭션을 커밋하는데 실패했습니다.")
+	}
+
+	return nil
+}
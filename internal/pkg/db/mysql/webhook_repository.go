@@ -0,0 +1,414 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/myramen/be/internal/app/webhook"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+type webhookRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookRepository(db *sql.DB) webhook.Repository {
+	return &webhookRepository{db: db}
+}
+
+func (r *webhookRepository) CreateSubscription(ctx context.Context, subscription *webhook.Subscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (
+			url, secret, event_types, description, active, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	eventTypesJSON, err := json.Marshal(subscription.EventTypes)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "이벤트 유형을 직렬화하는데 실패했습니다.")
+	}
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		subscription.URL, subscription.Secret, eventTypesJSON, nullString(subscription.Description),
+		subscription.Active, subscription.CreatedAt, subscription.UpdatedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 구독을 저장하는데 실패했습니다.")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 구독을 저장하는데 실패했습니다.")
+	}
+	subscription.ID = id
+
+	return nil
+}
+
+// subscriptionColumns 웹훅 구독 조회 시 사용하는 컬럼 목록 (scanSubscription과 순서가 같아야 함)
+const subscriptionColumns = `
+	id, url, secret, event_types, description, active, created_at, updated_at
+`
+
+// scanSubscription 조회 결과 한 행을 웹훅 구독으로 변환
+func scanSubscription(scanner rowScanner) (*webhook.Subscription, error) {
+	var (
+		subscription   webhook.Subscription
+		eventTypesJSON []byte
+		description    sql.NullString
+	)
+
+	if err := scanner.Scan(
+		&subscription.ID, &subscription.URL, &subscription.Secret, &eventTypesJSON, &description,
+		&subscription.Active, &subscription.CreatedAt, &subscription.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(eventTypesJSON, &subscription.EventTypes); err != nil {
+		return nil, err
+	}
+	subscription.Description = description.String
+
+	return &subscription, nil
+}
+
+func (r *webhookRepository) FindSubscriptionByID(ctx context.Context, id int64) (*webhook.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions WHERE id = ?`
+
+	subscription, err := scanSubscription(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "웹훅 구독을 조회하는데 실패했습니다.")
+	}
+
+	return subscription, nil
+}
+
+func (r *webhookRepository) FindSubscriptions(ctx context.Context) ([]webhook.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions ORDER BY id`
+
+	return r.querySubscriptions(ctx, query)
+}
+
+func (r *webhookRepository) FindActiveSubscriptions(ctx context.Context, eventType string) ([]webhook.Subscription, error) {
+	query := `
+		SELECT ` + subscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE active = TRUE AND JSON_CONTAINS(event_types, JSON_QUOTE(?))
+		ORDER BY id
+	`
+
+	return r.querySubscriptions(ctx, query, eventType)
+}
+
+func (r *webhookRepository) querySubscriptions(ctx context.Context, query string, args ...interface{}) ([]webhook.Subscription, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "웹훅 구독 목록을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var subscriptions []webhook.Subscription
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "웹훅 구독 정보를 읽는데 실패했습니다.")
+		}
+
+		subscriptions = append(subscriptions, *subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "웹훅 구독 목록을 조회하는데 실패했습니다.")
+	}
+
+	return subscriptions, nil
+}
+
+func (r *webhookRepository) UpdateSubscription(ctx context.Context, subscription *webhook.Subscription) error {
+	query := `
+		UPDATE webhook_subscriptions
+		SET url = ?, event_types = ?, description = ?, active = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	eventTypesJSON, err := json.Marshal(subscription.EventTypes)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "이벤트 유형을 직렬화하는데 실패했습니다.")
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(
+		ctx, query,
+		subscription.URL, eventTypesJSON, nullString(subscription.Description), subscription.Active,
+		subscription.UpdatedAt, subscription.ID,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 구독을 업데이트하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) DeleteSubscription(ctx context.Context, id int64) error {
+	query := `DELETE FROM webhook_subscriptions WHERE id = ?`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, id); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 구독을 삭제하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) CreateDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (
+			subscription_id, event_id, event_type, payload, status, attempts,
+			next_attempt_at, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		delivery.SubscriptionID, delivery.EventID, delivery.EventType, delivery.Payload, delivery.Status,
+		delivery.Attempts, delivery.NextAttemptAt, delivery.CreatedAt, delivery.UpdatedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 전달을 저장하는데 실패했습니다.")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 전달을 저장하는데 실패했습니다.")
+	}
+	delivery.ID = id
+
+	return nil
+}
+
+// deliveryColumns 웹훅 전달 조회 시 사용하는 컬럼 목록 (scanDelivery와 순서가 같아야 함)
+const deliveryColumns = `
+	id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+	last_status_code, last_error, delivered_at, created_at, updated_at
+`
+
+// scanDelivery 조회 결과 한 행을 웹훅 전달로 변환
+func scanDelivery(scanner rowScanner) (*webhook.Delivery, error) {
+	var (
+		delivery       webhook.Delivery
+		lastStatusCode sql.NullInt64
+		lastError      sql.NullString
+		deliveredAt    sql.NullTime
+	)
+
+	if err := scanner.Scan(
+		&delivery.ID, &delivery.SubscriptionID, &delivery.EventID, &delivery.EventType, &delivery.Payload,
+		&delivery.Status, &delivery.Attempts, &delivery.NextAttemptAt,
+		&lastStatusCode, &lastError, &deliveredAt, &delivery.CreatedAt, &delivery.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	delivery.LastStatusCode = int(lastStatusCode.Int64)
+	delivery.LastError = lastError.String
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+
+	return &delivery, nil
+}
+
+func (r *webhookRepository) FindDeliveryByID(ctx context.Context, id int64) (*webhook.Delivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries WHERE id = ?`
+
+	delivery, err := scanDelivery(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "웹훅 전달 기록을 조회하는데 실패했습니다.")
+	}
+
+	return delivery, nil
+}
+
+func (r *webhookRepository) FindDeliveries(ctx context.Context, filter webhook.DeliveryFilter) ([]webhook.Delivery, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if filter.SubscriptionID != 0 {
+		conditions = append(conditions, "subscription_id = ?")
+		args = append(args, filter.SubscriptionID)
+	}
+
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries` + whereClause(conditions) +
+		` ORDER BY id DESC LIMIT ?`
+	args = append(args, filter.Limit)
+
+	return r.queryDeliveries(ctx, query, args...)
+}
+
+func (r *webhookRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]webhook.Delivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE status = ? AND next_attempt_at <= ?
+		ORDER BY next_attempt_at, id
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED
+	`
+
+	return r.queryDeliveries(ctx, query, webhook.DeliveryPending, now, limit)
+}
+
+func (r *webhookRepository) queryDeliveries(ctx context.Context, query string, args ...interface{}) ([]webhook.Delivery, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "웹훅 전달 기록을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var deliveries []webhook.Delivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "웹훅 전달 기록을 읽는데 실패했습니다.")
+		}
+
+		deliveries = append(deliveries, *delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "웹훅 전달 기록을 조회하는데 실패했습니다.")
+	}
+
+	return deliveries, nil
+}
+
+func (r *webhookRepository) LeaseDeliveries(ctx context.Context, ids []int64, until time.Time) error {
+	query := `UPDATE webhook_deliveries SET next_attempt_at = ? WHERE id IN (` + placeholders(len(ids)) + `)`
+
+	args := []interface{}{until}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, args...); err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 전달을 예약하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) UpdateDeliveryResult(ctx context.Context, delivery *webhook.Delivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = ?, attempts = ?, next_attempt_at = ?, last_status_code = ?, last_error = ?,
+			delivered_at = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	var lastStatusCode sql.NullInt64
+	if delivery.LastStatusCode != 0 {
+		lastStatusCode = sql.NullInt64{Int64: int64(delivery.LastStatusCode), Valid: true}
+	}
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		delivery.Status, delivery.Attempts, delivery.NextAttemptAt, lastStatusCode,
+		nullString(delivery.LastError), delivery.DeliveredAt, delivery.UpdatedAt, delivery.ID,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 전달 결과를 저장하는데 실패했습니다.")
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) AddAttempt(ctx context.Context, attempt *webhook.Attempt) error {
+	query := `
+		INSERT INTO webhook_delivery_attempts (
+			delivery_id, status_code, error, response_body, duration_ms, attempted_at
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	var statusCode sql.NullInt64
+	if attempt.StatusCode != 0 {
+		statusCode = sql.NullInt64{Int64: int64(attempt.StatusCode), Valid: true}
+	}
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx, query,
+		attempt.DeliveryID, statusCode, nullString(attempt.Error), nullString(attempt.ResponseBody),
+		attempt.DurationMs, attempt.AttemptedAt,
+	)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 전달 시도를 기록하는데 실패했습니다.")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "웹훅 전달 시도를 기록하는데 실패했습니다.")
+	}
+	attempt.ID = id
+
+	return nil
+}
+
+func (r *webhookRepository) FindAttempts(ctx context.Context, deliveryID int64) ([]webhook.Attempt, error) {
+	query := `
+		SELECT id, delivery_id, status_code, error, response_body, duration_ms, attempted_at
+		FROM webhook_delivery_attempts
+		WHERE delivery_id = ?
+		ORDER BY id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, deliveryID)
+	if err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "웹훅 전달 시도 기록을 조회하는데 실패했습니다.")
+	}
+	defer rows.Close()
+
+	var attempts []webhook.Attempt
+	for rows.Next() {
+		var (
+			attempt      webhook.Attempt
+			statusCode   sql.NullInt64
+			attemptError sql.NullString
+			responseBody sql.NullString
+		)
+
+		if err := rows.Scan(
+			&attempt.ID, &attempt.DeliveryID, &statusCode, &attemptError, &responseBody,
+			&attempt.DurationMs, &attempt.AttemptedAt,
+		); err != nil {
+			return nil, errors.Internal("INTERNAL_ERROR", "웹훅 전달 시도 정보를 읽는데 실패했습니다.")
+		}
+
+		attempt.StatusCode = int(statusCode.Int64)
+		attempt.Error = attemptError.String
+		attempt.ResponseBody = responseBody.String
+
+		attempts = append(attempts, attempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Internal("INTERNAL_ERROR", "웹훅 전달 시도 기록을 조회하는데 실패했습니다.")
+	}
+
+	return attempts, nil
+}
@@ -0,0 +1,36 @@
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// BlindIndex 평문을 저장하지 않고 같은 값인지 찾기 위한 키 기반 해시 (HMAC-SHA256)
+// 봉투 암호화한 값은 매번 암호문이 달라서 검색할 수 없으므로, 검색이 필요한 값은 이 해시를 함께 저장한다.
+// 키를 바꾸면 기존 해시로는 찾을 수 없으므로 다시 계산해야 한다.
+type BlindIndex struct {
+	key []byte
+}
+
+// NewBlindIndex 키로 블라인드 인덱스 생성
+func NewBlindIndex(key string) *BlindIndex {
+	return &BlindIndex{key: []byte(key)}
+}
+
+// Enabled 키가 설정되어 있는지 여부
+func (b *BlindIndex) Enabled() bool {
+	return b != nil && len(b.key) > 0
+}
+
+// Compute 값 목록의 해시 (hex 64자, 값 사이에 구분자를 넣어 경계가 섞이지 않게 함)
+func (b *BlindIndex) Compute(values ...string) string {
+	mac := hmac.New(sha256.New, b.key)
+	for i, value := range values {
+		if i > 0 {
+			mac.Write([]byte{0})
+		}
+		mac.Write([]byte(value))
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
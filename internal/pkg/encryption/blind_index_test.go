@@ -0,0 +1,34 @@
+package encryption
+
+import "testing"
+
+func TestBlindIndexIsStable(t *testing.T) {
+	index := NewBlindIndex("claim-key")
+
+	first := index.Compute("김철수", "110-123-456789")
+	if len(first) != 64 {
+		t.Fatalf("Compute() = %q, want 64 hex characters", first)
+	}
+
+	// 다시 만든 인덱스로도 같은 값은 같은 해시
+	if again := NewBlindIndex("claim-key").Compute("김철수", "110-123-456789"); again != first {
+		t.Errorf("Compute() = %s, then %s for the same values", first, again)
+	}
+
+	if other := index.Compute("김철수", "110-123-456788"); other == first {
+		t.Error("different values produced the same hash")
+	}
+
+	// 값 경계가 바뀌면 다른 해시
+	if shifted := index.Compute("김철수110", "-123-456789"); shifted == first {
+		t.Error("moving the boundary between values produced the same hash")
+	}
+
+	if rekeyed := NewBlindIndex("other-key").Compute("김철수", "110-123-456789"); rekeyed == first {
+		t.Error("a different key produced the same hash")
+	}
+
+	if NewBlindIndex("").Enabled() || !index.Enabled() {
+		t.Error("Enabled() does not reflect whether a key is set")
+	}
+}
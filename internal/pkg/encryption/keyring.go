@@ -0,0 +1,186 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// envelopeVersion 암호문 형식 버전
+const envelopeVersion = "v1"
+
+// Keyring 키 ID별 마스터 키(KEK) 모음
+// 값마다 새 데이터 키(DEK)를 만들어 암호화하고, DEK는 활성 마스터 키로 감싸서 함께 저장한다(봉투 암호화).
+// 키 교체 시 새 키를 활성화해도 이전 키 ID로 저장된 값은 계속 복호화할 수 있다.
+type Keyring struct {
+	keys     map[string][]byte
+	activeID string
+}
+
+// NewKeyring "키ID:base64(32바이트 키)" 항목을 쉼표로 구분한 명세로 키링 생성
+// spec이 비어 있으면 암호화가 비활성화된 키링을 반환한다.
+func NewKeyring(spec string, activeID string) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string][]byte)}
+
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return k, nil
+	}
+
+	for _, entry := range strings.Split(spec, ",") {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid key entry %q: expected <keyId>:<base64 key>", entry)
+		}
+
+		if len(id) > 32 {
+			return nil, fmt.Errorf("key id %q is longer than 32 characters", id)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not valid base64: %w", id, err)
+		}
+
+		if len(key) != 32 {
+			return nil, fmt.Errorf("key %q must be 32 bytes, got %d", id, len(key))
+		}
+
+		k.keys[id] = key
+	}
+
+	if activeID == "" {
+		return nil, fmt.Errorf("active key id is required when keys are configured")
+	}
+
+	if _, ok := k.keys[activeID]; !ok {
+		return nil, fmt.Errorf("active key id %q is not in the keyring", activeID)
+	}
+
+	k.activeID = activeID
+	return k, nil
+}
+
+// Enabled 암호화 키가 설정되어 있는지 여부
+func (k *Keyring) Enabled() bool {
+	return k != nil && k.activeID != ""
+}
+
+// ActiveKeyID 새 값을 암호화할 때 사용하는 키 ID
+func (k *Keyring) ActiveKeyID() string {
+	if k == nil {
+		return ""
+	}
+	return k.activeID
+}
+
+// Encrypt 활성 키로 평문을 봉투 암호화하고 암호문과 키 ID를 반환
+func (k *Keyring) Encrypt(plaintext string) (ciphertext string, keyID string, err error) {
+	if !k.Enabled() {
+		return "", "", fmt.Errorf("encryption is not configured")
+	}
+
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return "", "", fmt.Errorf("generate data key: %w", err)
+	}
+
+	// 키 ID를 추가 인증 데이터로 사용해 다른 키 ID로 바꿔치기하는 것을 막음
+	wrapped, err := seal(k.keys[k.activeID], dek, []byte(k.activeID))
+	if err != nil {
+		return "", "", fmt.Errorf("wrap data key: %w", err)
+	}
+
+	sealed, err := seal(dek, []byte(plaintext), nil)
+	if err != nil {
+		return "", "", fmt.Errorf("encrypt value: %w", err)
+	}
+
+	ciphertext = strings.Join([]string{
+		envelopeVersion,
+		base64.RawStdEncoding.EncodeToString(wrapped),
+		base64.RawStdEncoding.EncodeToString(sealed),
+	}, ".")
+
+	return ciphertext, k.activeID, nil
+}
+
+// Decrypt 키 ID에 해당하는 마스터 키로 암호문을 복호화
+func (k *Keyring) Decrypt(ciphertext string, keyID string) (string, error) {
+	if k == nil {
+		return "", fmt.Errorf("encryption is not configured")
+	}
+
+	kek, ok := k.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("unknown key id %q", keyID)
+	}
+
+	parts := strings.Split(ciphertext, ".")
+	if len(parts) != 3 || parts[0] != envelopeVersion {
+		return "", fmt.Errorf("unsupported ciphertext format")
+	}
+
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("decode data key: %w", err)
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("decode value: %w", err)
+	}
+
+	dek, err := open(kek, wrapped, []byte(keyID))
+	if err != nil {
+		return "", fmt.Errorf("unwrap data key: %w", err)
+	}
+
+	plaintext, err := open(dek, sealed, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt value: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// seal AES-256-GCM 암호화 (nonce를 암호문 앞에 붙임)
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open seal로 만든 암호문 복호화
+func open(key, sealed, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,143 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+// testKey 키 명세에 쓸 base64 32바이트 키
+func testKey(fill byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{fill}, 32))
+}
+
+func mustKeyring(t *testing.T, spec string, activeID string) *Keyring {
+	t.Helper()
+
+	k, err := NewKeyring(spec, activeID)
+	if err != nil {
+		t.Fatalf("NewKeyring(%q, %q) error = %v", spec, activeID, err)
+	}
+	return k
+}
+
+func TestKeyringRoundTrip(t *testing.T) {
+	k := mustKeyring(t, "k1:"+testKey(1), "k1")
+
+	for _, plaintext := range []string{"", "110-123-456789", "김철수"} {
+		ciphertext, keyID, err := k.Encrypt(plaintext)
+		if err != nil {
+			t.Fatalf("Encrypt(%q) error = %v", plaintext, err)
+		}
+
+		if keyID != "k1" {
+			t.Errorf("Encrypt(%q) key id = %q, want k1", plaintext, keyID)
+		}
+
+		if plaintext != "" && strings.Contains(ciphertext, plaintext) {
+			t.Errorf("ciphertext %q contains the plaintext", ciphertext)
+		}
+
+		got, err := k.Decrypt(ciphertext, keyID)
+		if err != nil || got != plaintext {
+			t.Errorf("Decrypt() = %q, %v, want %q", got, err, plaintext)
+		}
+	}
+
+	// 같은 값도 매번 다른 암호문
+	first, _, _ := k.Encrypt("110-123-456789")
+	second, _, _ := k.Encrypt("110-123-456789")
+	if first == second {
+		t.Error("encrypting the same value twice produced the same ciphertext")
+	}
+}
+
+func TestKeyringDecryptsOldKeyAfterRotation(t *testing.T) {
+	old := mustKeyring(t, "k1:"+testKey(1), "k1")
+	ciphertext, keyID, err := old.Encrypt("110-123-456789")
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	rotated := mustKeyring(t, "k1:"+testKey(1)+", k2:"+testKey(2), "k2")
+
+	got, err := rotated.Decrypt(ciphertext, keyID)
+	if err != nil || got != "110-123-456789" {
+		t.Fatalf("Decrypt() with old key id = %q, %v, want the plaintext", got, err)
+	}
+
+	ciphertext, keyID, err = rotated.Encrypt("110-123-456789")
+	if err != nil || keyID != "k2" {
+		t.Fatalf("Encrypt() after rotation key id = %q, %v, want k2", keyID, err)
+	}
+
+	if _, err := old.Decrypt(ciphertext, keyID); err == nil {
+		t.Error("keyring without the new key decrypted a value encrypted with it")
+	}
+}
+
+func TestKeyringRejectsTamperedCiphertext(t *testing.T) {
+	k := mustKeyring(t, "k1:"+testKey(1)+",k2:"+testKey(2), "k1")
+	ciphertext, keyID, err := k.Encrypt("110-123-456789")
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	parts := strings.Split(ciphertext, ".")
+	sealed, _ := base64.RawStdEncoding.DecodeString(parts[2])
+	sealed[len(sealed)-1] ^= 1
+	tampered := strings.Join([]string{parts[0], parts[1], base64.RawStdEncoding.EncodeToString(sealed)}, ".")
+
+	if _, err := k.Decrypt(tampered, keyID); err == nil {
+		t.Error("Decrypt() accepted a tampered ciphertext")
+	}
+
+	// 키 ID를 바꿔치기하면 데이터 키를 풀 수 없음
+	if _, err := k.Decrypt(ciphertext, "k2"); err == nil {
+		t.Error("Decrypt() accepted a ciphertext under another key id")
+	}
+
+	// 같은 키 ID라도 키가 다르면 복호화할 수 없음
+	other := mustKeyring(t, "k1:"+testKey(9), "k1")
+	if _, err := other.Decrypt(ciphertext, keyID); err == nil {
+		t.Error("Decrypt() succeeded with the wrong key")
+	}
+
+	if _, err := k.Decrypt(ciphertext, "k3"); err == nil {
+		t.Error("Decrypt() succeeded with an unknown key id")
+	}
+
+	if _, err := k.Decrypt("v0."+parts[1]+"."+parts[2], keyID); err == nil {
+		t.Error("Decrypt() accepted an unsupported format version")
+	}
+}
+
+func TestNewKeyring(t *testing.T) {
+	disabled := mustKeyring(t, "", "")
+	if disabled.Enabled() {
+		t.Error("keyring without keys is enabled")
+	}
+	if _, _, err := disabled.Encrypt("value"); err == nil {
+		t.Error("Encrypt() succeeded without keys")
+	}
+
+	invalid := []struct {
+		name     string
+		spec     string
+		activeID string
+	}{
+		{name: "missing key id", spec: ":" + testKey(1), activeID: "k1"},
+		{name: "not base64", spec: "k1:not-base64!", activeID: "k1"},
+		{name: "short key", spec: "k1:" + base64.StdEncoding.EncodeToString([]byte("short")), activeID: "k1"},
+		{name: "no active key", spec: "k1:" + testKey(1), activeID: ""},
+		{name: "unknown active key", spec: "k1:" + testKey(1), activeID: "k2"},
+		{name: "long key id", spec: strings.Repeat("k", 33) + ":" + testKey(1), activeID: strings.Repeat("k", 33)},
+	}
+
+	for _, tt := range invalid {
+		if _, err := NewKeyring(tt.spec, tt.activeID); err == nil {
+			t.Errorf("NewKeyring() with %s succeeded", tt.name)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package encryption
+
+import (
+	"crypto/sha256"
+)
+
+// SealWithSecret secret에서 유도한 키로 평문을 AES-256-GCM 암호화
+// 서버에 키를 두지 않고 secret을 가진 쪽(예: 요청한 클라이언트)만 다시 열 수 있게 저장할 때 사용한다.
+func SealWithSecret(secret string, plaintext []byte) ([]byte, error) {
+	return seal(secretKey(secret), plaintext, nil)
+}
+
+// OpenWithSecret SealWithSecret으로 만든 암호문 복호화
+func OpenWithSecret(secret string, sealed []byte) ([]byte, error) {
+	return open(secretKey(secret), sealed, nil)
+}
+
+// secretKey secret에서 32바이트 암호화 키 유도
+func secretKey(secret string) []byte {
+	sum := sha256.Sum256([]byte("myramen:secret-box:" + secret))
+	return sum[:]
+}
@@ -0,0 +1,37 @@
+package encryption
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSecretBoxRoundTrip(t *testing.T) {
+	plaintext := []byte(`{"orderId":"o01JTWQ8H5X2M4K7N9P3R6S8V0Y","lookupToken":"q0V1oZ6n3b2"}`)
+
+	sealed, err := SealWithSecret("idempotency-key-1", plaintext)
+	if err != nil {
+		t.Fatalf("SealWithSecret() error = %v", err)
+	}
+
+	if bytes.Contains(sealed, []byte("lookupToken")) {
+		t.Error("sealed value contains the plaintext")
+	}
+
+	opened, err := OpenWithSecret("idempotency-key-1", sealed)
+	if err != nil || !bytes.Equal(opened, plaintext) {
+		t.Fatalf("OpenWithSecret() = %s, %v, want the plaintext", opened, err)
+	}
+
+	if _, err := OpenWithSecret("idempotency-key-2", sealed); err == nil {
+		t.Error("OpenWithSecret() succeeded with the wrong secret")
+	}
+
+	sealed[len(sealed)-1] ^= 1
+	if _, err := OpenWithSecret("idempotency-key-1", sealed); err == nil {
+		t.Error("OpenWithSecret() accepted a tampered value")
+	}
+
+	if _, err := OpenWithSecret("idempotency-key-1", []byte("short")); err == nil {
+		t.Error("OpenWithSecret() accepted a value shorter than the nonce")
+	}
+}
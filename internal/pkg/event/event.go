@@ -0,0 +1,107 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/idgen"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+)
+
+// 도메인 이벤트 유형
+const (
+	OrderCreated       = "order.created"
+	OrderStatusChanged = "order.status_changed"
+	CouponIssued       = "coupon.issued"
+	CouponRedeemed     = "coupon.redeemed"
+	CouponExpired      = "coupon.expired"
+	CouponExpiringSoon = "coupon.expiring_soon"
+	CouponTransferred  = "coupon.transferred"
+	CouponRevoked      = "coupon.revoked"
+	CouponExtended     = "coupon.extended"
+	InventoryLowStock  = "inventory.low_stock"
+)
+
+// Types 모든 도메인 이벤트 유형
+var Types = []string{
+	OrderCreated,
+	OrderStatusChanged,
+	CouponIssued,
+	CouponRedeemed,
+	CouponExpired,
+	CouponExpiringSoon,
+	CouponTransferred,
+	CouponRevoked,
+	CouponExtended,
+	InventoryLowStock,
+}
+
+// 이벤트가 속한 집합체 유형
+const (
+	AggregateOrder     = "order"
+	AggregateCoupon    = "coupon"
+	AggregateInventory = "inventory"
+)
+
+// Event outbox에 기록된 도메인 이벤트
+type Event struct {
+	// Seq outbox 기록 순번 (같은 집합체 안에서 발행 순서)
+	Seq           int64
+	ID            string
+	Type          string
+	AggregateType string
+	AggregateID   string
+	Payload       json.RawMessage
+	OccurredAt    time.Time
+	Attempts      int
+}
+
+// Recorder 상태 변경과 같은 트랜잭션 안에서 도메인 이벤트를 기록하는 인터페이스
+type Recorder interface {
+	Record(ctx context.Context, aggregateType, aggregateID, eventType string, payload interface{}) error
+}
+
+// Store outbox 저장소 인터페이스
+type Store interface {
+	// Append 이벤트 기록
+	Append(ctx context.Context, event *Event) error
+
+	// FetchPending 발행할 이벤트를 잠가서 순번 순으로 조회
+	// 집합체마다 아직 발행되지 않은 가장 앞선 이벤트만 반환하며, 다른 인스턴스가 잠근 행은 건너뛴다.
+	// 트랜잭션 안에서 호출해야 한다.
+	FetchPending(ctx context.Context, now time.Time, limit int) ([]Event, error)
+
+	// MarkPublished 이벤트 발행 완료 처리
+	MarkPublished(ctx context.Context, seq int64, publishedAt time.Time) error
+
+	// MarkFailed 발행 실패 기록 (availableAt 이후 다시 시도)
+	MarkFailed(ctx context.Context, seq int64, lastError string, availableAt time.Time) error
+}
+
+// Outbox Store에 이벤트를 기록하는 Recorder
+type Outbox struct {
+	store Store
+}
+
+// NewOutbox outbox 기록기 생성
+func NewOutbox(store Store) *Outbox {
+	return &Outbox{store: store}
+}
+
+// Record 이벤트를 직렬화해 outbox에 기록 (호출한 쪽의 트랜잭션에 합류)
+func (o *Outbox) Record(ctx context.Context, aggregateType, aggregateID, eventType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Internal("INTERNAL_ERROR", "이벤트를 직렬화하는데 실패했습니다.")
+	}
+
+	return o.store.Append(ctx, &Event{
+		ID:            "evt_" + idgen.NewToken(),
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       data,
+		OccurredAt:    time.Now(),
+	})
+}
@@ -0,0 +1,133 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/db"
+)
+
+// relay 설정
+const (
+	// relayBatchSize 한 트랜잭션에서 발행하는 최대 이벤트 수
+	relayBatchSize = 100
+	// relayRetryBaseDelay 발행 실패 후 첫 재시도 대기 시간 (이후 2배씩 증가)
+	relayRetryBaseDelay = time.Second
+	// relayRetryMaxDelay 재시도 대기 시간 상한
+	relayRetryMaxDelay = 5 * time.Minute
+	// relayDrainTimeout 종료 시 남은 이벤트를 발행하는 최대 시간
+	relayDrainTimeout = 10 * time.Second
+)
+
+// Relay outbox의 이벤트를 sink들로 발행하는 백그라운드 작업
+// 이벤트는 집합체마다 기록 순서대로 발행하며, 발행에 실패한 집합체의 이후 이벤트는 기다린다.
+type Relay struct {
+	store    Store
+	tx       db.Transactor
+	sinks    []Sink
+	interval time.Duration
+}
+
+// NewRelay relay 생성
+func NewRelay(store Store, tx db.Transactor, interval time.Duration, sinks ...Sink) *Relay {
+	return &Relay{
+		store:    store,
+		tx:       tx,
+		sinks:    sinks,
+		interval: interval,
+	}
+}
+
+// Run ctx가 취소될 때까지 이벤트 발행
+// 취소되면 남은 이벤트를 한 번 더 발행한 뒤 반환한다.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			drainCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), relayDrainTimeout)
+			r.drain(drainCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			r.drain(ctx)
+		}
+	}
+}
+
+// drain 발행할 이벤트가 없을 때까지 반복
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		published, err := r.publishBatch(ctx)
+		if err != nil {
+			log.Printf("event relay: %v", err)
+			return
+		}
+
+		if published == 0 {
+			return
+		}
+	}
+}
+
+// publishBatch 이벤트 한 묶음을 하나의 트랜잭션으로 발행하고 발행한 수 반환
+func (r *Relay) publishBatch(ctx context.Context) (int, error) {
+	published := 0
+
+	err := r.tx.WithinTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+
+		events, err := r.store.FetchPending(ctx, now, relayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := r.publish(ctx, event); err != nil {
+				log.Printf("event relay: failed to publish %s (%s): %v", event.ID, event.Type, err)
+
+				retryAt := now.Add(retryDelay(event.Attempts + 1))
+				if err := r.store.MarkFailed(ctx, event.Seq, err.Error(), retryAt); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := r.store.MarkPublished(ctx, event.Seq, now); err != nil {
+				return err
+			}
+			published++
+		}
+
+		return nil
+	})
+
+	return published, err
+}
+
+// publish 모든 sink로 이벤트 전달
+func (r *Relay) publish(ctx context.Context, event Event) error {
+	for _, sink := range r.sinks {
+		if err := sink.Handle(ctx, event); err != nil {
+			return fmt.Errorf("%s: %w", sink.Name(), err)
+		}
+	}
+	return nil
+}
+
+// retryDelay n번째 실패 후 다시 시도하기까지 대기 시간 (1초, 2초, 4초, ... 최대 5분)
+func retryDelay(attempts int) time.Duration {
+	delay := relayRetryBaseDelay
+	for i := 1; i < attempts && delay < relayRetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > relayRetryMaxDelay {
+		return relayRetryMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,60 @@
+package event
+
+import (
+	"context"
+	"log"
+
+	"github.com/myramen/be/internal/pkg/pubsub"
+)
+
+// Sink 발행된 이벤트를 받는 대상
+// relay 트랜잭션 안에서 호출되며, 오류를 반환하면 이벤트를 나중에 다시 발행한다. (최소 1회 전달)
+type Sink interface {
+	Name() string
+	Handle(ctx context.Context, event Event) error
+}
+
+// BusSink 프로세스 내부 구독자(실시간 스트림 등)에게 이벤트 전달
+type BusSink struct {
+	hub *pubsub.Hub
+}
+
+// NewBusSink 내부 버스 sink 생성
+func NewBusSink(hub *pubsub.Hub) *BusSink {
+	return &BusSink{hub: hub}
+}
+
+// Name sink 이름
+func (s *BusSink) Name() string {
+	return "bus"
+}
+
+// Handle 이벤트를 집합체 ID를 주제로 발행 (데이터는 Event 그대로)
+func (s *BusSink) Handle(ctx context.Context, event Event) error {
+	s.hub.Publish(event.AggregateID, event.Type, event)
+	return nil
+}
+
+// LogSink 이벤트를 로그로 남김
+type LogSink struct {
+	logger *log.Logger
+}
+
+// NewLogSink 로그 sink 생성 (logger가 nil이면 기본 로거 사용)
+func NewLogSink(logger *log.Logger) *LogSink {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogSink{logger: logger}
+}
+
+// Name sink 이름
+func (s *LogSink) Name() string {
+	return "log"
+}
+
+// Handle 이벤트 요약 출력
+func (s *LogSink) Handle(ctx context.Context, event Event) error {
+	s.logger.Printf("event: %s %s %s/%s", event.ID, event.Type, event.AggregateType, event.AggregateID)
+	return nil
+}
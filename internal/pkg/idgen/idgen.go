@@ -0,0 +1,173 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// crockford Crockford Base32 문자 집합 (혼동되는 I, L, O, U 제외)
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// 레거시(밀리초 타임스탬프 기반) ID 형식
+var (
+	legacyOrderIDPattern  = regexp.MustCompile(`^o[0-9]{13}$`)
+	legacyCouponIDPattern = regexp.MustCompile(`^c[0-9]{13}$`)
+)
+
+// Generator ID 생성기 인터페이스
+type Generator interface {
+	// NewOrderID 새 주문 ID 생성
+	NewOrderID() string
+
+	// NewCouponID 새 쿠폰 코드 생성
+	NewCouponID() string
+}
+
+// RandomGenerator ULID 기반 주문 ID와 체크섬이 포함된 무작위 쿠폰 코드를 생성
+type RandomGenerator struct {
+	now func() time.Time
+}
+
+// NewRandomGenerator 기본 ID 생성기 생성
+func NewRandomGenerator() *RandomGenerator {
+	return &RandomGenerator{now: time.Now}
+}
+
+// NewOrderID "o" + ULID (48비트 밀리초 타임스탬프 + 80비트 난수, 26자)
+// 난수부는 ID마다 새로 뽑으므로 같은 밀리초에 만든 주문의 ID로 다른 주문의 ID를 추측할 수 없다.
+// (같은 밀리초 안에서는 생성 순서대로 정렬되지 않음)
+func (g *RandomGenerator) NewOrderID() string {
+	ms := uint64(g.now().UnixMilli())
+
+	var id [16]byte
+	id[0] = byte(ms >> 40)
+	id[1] = byte(ms >> 32)
+	binary.BigEndian.PutUint32(id[2:6], uint32(ms))
+	mustRead(id[6:])
+
+	return "o" + encodeULID(id)
+}
+
+// NewCouponID 사람이 읽고 입력하기 쉬운 쿠폰 코드 (예: 7K3M-Q9XD-2HF5)
+// 무작위 11자(55비트)와 오타 검출용 체크섬 1자로 구성된다.
+func (g *RandomGenerator) NewCouponID() string {
+	var buf [11]byte
+	mustRead(buf[:])
+
+	code := make([]byte, 0, 12)
+	for _, b := range buf {
+		code = append(code, crockford[b&0x1f])
+	}
+	code = append(code, checksum(string(code)))
+
+	return string(code[0:4]) + "-" + string(code[4:8]) + "-" + string(code[8:12])
+}
+
+// IsOrderID 주문 ID 형식 검사 (신규 ULID 형식과 레거시 형식 모두 허용)
+func IsOrderID(id string) bool {
+	if legacyOrderIDPattern.MatchString(id) {
+		return true
+	}
+
+	if len(id) != 27 || id[0] != 'o' {
+		return false
+	}
+
+	// 첫 글자는 48비트 타임스탬프 상위 비트이므로 0~7만 가능
+	if id[1] > '7' {
+		return false
+	}
+
+	for i := 1; i < len(id); i++ {
+		if strings.IndexByte(crockford, id[i]) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NormalizeCouponID 사용자가 입력한 쿠폰 코드를 저장 형식으로 정규화
+// 소문자, 공백, 하이픈 누락, 혼동 문자(I/L → 1, O → 0)를 보정한다.
+// 레거시 형식(c + 밀리초)은 그대로 반환한다.
+func NormalizeCouponID(input string) string {
+	input = strings.TrimSpace(input)
+	if legacyCouponIDPattern.MatchString(input) {
+		return input
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToUpper(input) {
+		switch r {
+		case '-', ' ':
+			continue
+		case 'I', 'L':
+			r = '1'
+		case 'O':
+			r = '0'
+		}
+		b.WriteRune(r)
+	}
+
+	code := b.String()
+	if len(code) != 12 {
+		return input
+	}
+
+	return code[0:4] + "-" + code[4:8] + "-" + code[8:12]
+}
+
+// IsCouponID 쿠폰 코드 형식 및 체크섬 검사 (레거시 형식도 허용)
+// 정규화된 코드를 전달해야 한다.
+func IsCouponID(id string) bool {
+	if legacyCouponIDPattern.MatchString(id) {
+		return true
+	}
+
+	if len(id) != 14 || id[4] != '-' || id[9] != '-' {
+		return false
+	}
+
+	code := id[0:4] + id[5:9] + id[10:14]
+	for i := 0; i < 11; i++ {
+		if strings.IndexByte(crockford, code[i]) < 0 {
+			return false
+		}
+	}
+
+	return checksum(code[:11]) == code[11]
+}
+
+// checksum 자리별 가중치 합의 mod 31 체크섬 문자 계산
+// 한 글자 오타와 인접 글자 뒤바뀜을 검출한다.
+func checksum(code string) byte {
+	sum := 0
+	for i := 0; i < len(code); i++ {
+		sum = (sum + (i+1)*strings.IndexByte(crockford, code[i])) % 31
+	}
+	return crockford[sum]
+}
+
+// encodeULID 128비트 값을 Crockford Base32 26자로 인코딩
+func encodeULID(id [16]byte) string {
+	hi := binary.BigEndian.Uint64(id[0:8])
+	lo := binary.BigEndian.Uint64(id[8:16])
+
+	out := make([]byte, 26)
+	for i := 25; i >= 0; i-- {
+		out[i] = crockford[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(out)
+}
+
+// mustRead 암호학적 난수로 채움
+func mustRead(b []byte) {
+	if _, err := rand.Read(b); err != nil {
+		panic("idgen: failed to read random bytes: " + err.Error())
+	}
+}
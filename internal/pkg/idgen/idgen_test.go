@@ -0,0 +1,173 @@
+package idgen
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fixedGenerator 항상 같은 시각을 쓰는 생성기 (같은 밀리초 안의 생성 확인용)
+func fixedGenerator(now time.Time) *RandomGenerator {
+	return &RandomGenerator{now: func() time.Time { return now }}
+}
+
+// decodeULID 주문 ID의 ULID 부분을 128비트 값으로 변환
+func decodeULID(t *testing.T, orderID string) *big.Int {
+	t.Helper()
+
+	value := new(big.Int)
+	for i := 1; i < len(orderID); i++ {
+		digit := strings.IndexByte(crockford, orderID[i])
+		if digit < 0 {
+			t.Fatalf("order ID %q has invalid character %q", orderID, orderID[i])
+		}
+		value.Lsh(value, 5)
+		value.Add(value, big.NewInt(int64(digit)))
+	}
+	return value
+}
+
+func TestNewOrderIDFormat(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	id := fixedGenerator(now).NewOrderID()
+
+	if !IsOrderID(id) {
+		t.Fatalf("IsOrderID(%q) = false", id)
+	}
+
+	// 상위 48비트는 밀리초 타임스탬프
+	ms := new(big.Int).Rsh(decodeULID(t, id), 80)
+	if ms.Int64() != now.UnixMilli() {
+		t.Errorf("timestamp = %d, want %d", ms.Int64(), now.UnixMilli())
+	}
+
+	later := fixedGenerator(now.Add(time.Millisecond)).NewOrderID()
+	if later <= id {
+		t.Errorf("order ID of a later millisecond %q does not sort after %q", later, id)
+	}
+}
+
+func TestNewOrderIDIsNotPredictableWithinMillisecond(t *testing.T) {
+	g := fixedGenerator(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	seen := make(map[string]bool)
+	previous := decodeULID(t, g.NewOrderID())
+	for i := 0; i < 1000; i++ {
+		id := g.NewOrderID()
+		if seen[id] {
+			t.Fatalf("duplicate order ID %q", id)
+		}
+		seen[id] = true
+
+		// 같은 밀리초에 만든 주문의 ID에 1을 더한 값이 아니어야 함
+		current := decodeULID(t, id)
+		if diff := new(big.Int).Sub(current, previous); diff.Cmp(big.NewInt(1)) == 0 {
+			t.Fatalf("order ID %q is the previous order ID + 1", id)
+		}
+		previous = current
+	}
+}
+
+func TestNewCouponID(t *testing.T) {
+	g := NewRandomGenerator()
+
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		id := g.NewCouponID()
+		if len(id) != 14 || id[4] != '-' || id[9] != '-' {
+			t.Fatalf("coupon ID %q is not XXXX-XXXX-XXXX", id)
+		}
+
+		if !IsCouponID(id) {
+			t.Fatalf("IsCouponID(%q) = false", id)
+		}
+
+		if normalized := NormalizeCouponID(id); normalized != id {
+			t.Fatalf("NormalizeCouponID(%q) = %q, want unchanged", id, normalized)
+		}
+
+		if seen[id] {
+			t.Fatalf("duplicate coupon ID %q", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestNormalizeCouponID(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "7K3M-Q9XD-2HF5", want: "7K3M-Q9XD-2HF5"},
+		{input: "7k3m-q9xd-2hf5", want: "7K3M-Q9XD-2HF5"},
+		{input: " 7K3MQ9XD2HF5 ", want: "7K3M-Q9XD-2HF5"},
+		{input: "7K3M Q9XD 2HF5", want: "7K3M-Q9XD-2HF5"},
+		{input: "IL0O-Q9XD-2HF5", want: "1100-Q9XD-2HF5"},
+		{input: "c1700000000000", want: "c1700000000000"},
+		{input: "7K3M-Q9XD", want: "7K3M-Q9XD"},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeCouponID(tt.input); got != tt.want {
+			t.Errorf("NormalizeCouponID(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestIsCouponIDRejectsTypos(t *testing.T) {
+	code := "7K3MQ9XD2HF"
+	code += string(checksum(code))
+	format := func(code string) string {
+		return code[0:4] + "-" + code[4:8] + "-" + code[8:12]
+	}
+
+	if !IsCouponID(format(code)) {
+		t.Fatalf("IsCouponID(%q) = false", format(code))
+	}
+
+	// 한 글자 오타
+	for i := 0; i < len(code); i++ {
+		digit := strings.IndexByte(crockford, code[i])
+		replaced := []byte(code)
+		replaced[i] = crockford[(digit+1)%31]
+
+		if id := format(string(replaced)); IsCouponID(id) {
+			t.Errorf("IsCouponID(%q) = true for a typo at %d", id, i)
+		}
+	}
+
+	// 인접 글자 뒤바뀜
+	for i := 0; i+1 < len(code); i++ {
+		if code[i] == code[i+1] {
+			continue
+		}
+
+		swapped := []byte(code)
+		swapped[i], swapped[i+1] = swapped[i+1], swapped[i]
+
+		if id := format(string(swapped)); IsCouponID(id) {
+			t.Errorf("IsCouponID(%q) = true for swapped characters at %d", id, i)
+		}
+	}
+}
+
+func TestIsCouponIDFormats(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{id: "c1700000000000", want: true},
+		{id: "c170000000000", want: false},
+		{id: "7K3MQ9XD2HF5", want: false},
+		{id: "7K3M_Q9XD_2HF5", want: false},
+		{id: "7K3M-Q9XD-2HFU", want: false},
+		{id: "", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := IsCouponID(tt.id); got != tt.want {
+			t.Errorf("IsCouponID(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
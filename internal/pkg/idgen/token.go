@@ -0,0 +1,30 @@
+package idgen
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// NewToken 추측할 수 없는 비밀 토큰 생성 (32바이트 난수, URL-safe Base64 43자)
+func NewToken() string {
+	var buf [32]byte
+	mustRead(buf[:])
+	return base64.RawURLEncoding.EncodeToString(buf[:])
+}
+
+// HashToken 저장용 토큰 해시 (SHA-256 hex)
+// 토큰 원문은 저장하지 않고 해시만 저장한다.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyToken 토큰이 저장된 해시와 일치하는지 상수 시간으로 비교
+func VerifyToken(token, hash string) bool {
+	if token == "" || hash == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(HashToken(token)), []byte(hash)) == 1
+}
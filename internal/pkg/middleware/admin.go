@@ -1,7 +1,12 @@
 package middleware
 
 import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"log"
 	"net/http"
+	"strings"
 
 	"github.com/myramen/be/internal/pkg/config"
 	"github.com/myramen/be/internal/pkg/utils/errors"
@@ -9,10 +14,35 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// maxAdminNameLength 관리자 이름 최대 길이
+const maxAdminNameLength = 50
+
+// 브라우저 WebSocket은 헤더를 보낼 수 없으므로 Sec-WebSocket-Protocol 값으로 인증 정보를 받는다.
+// 비밀번호와 이름은 UTF-8 문자열을 base64url(패딩 없음)로 인코딩해서 접두사 뒤에 붙인다.
+const (
+	AdminPasswordProtocolPrefix = "admin-password."
+	AdminNameProtocolPrefix     = "admin-name."
+)
+
+// AdminAccount 관리자별 인증 정보
+type AdminAccount struct {
+	Name     string
+	Password string
+}
+
 // AdminAuth 관리자 인증 미들웨어
+// ADMIN_ACCOUNTS가 설정되어 있으면 비밀번호가 일치하는 관리자 이름을 처리자로 사용하고 공용 비밀번호는 받지 않는다.
+// 설정되어 있지 않으면 공용 비밀번호(ADMIN_PASSWORD)로 인증하고, X-Admin-Name 헤더의 이름은 확인할 수 없으므로 미확인으로 표시한다.
 func AdminAuth() gin.HandlerFunc {
+	accounts, err := ParseAdminAccounts(config.AppConfig.AdminAccounts)
+	if err != nil {
+		// 시작할 때 검증하므로 일어나지 않지만, 잘못된 설정 때문에 공용 비밀번호를 허용하지 않도록 모든 요청을 거부
+		log.Printf("Invalid ADMIN_ACCOUNTS: %v", err)
+		accounts = []AdminAccount{}
+	}
+
 	return func(c *gin.Context) {
-		adminPassword := c.GetHeader("X-Admin-Password")
+		adminPassword, adminName := adminCredentials(c.Request)
 		if adminPassword == "" {
 			c.JSON(http.StatusUnauthorized, errors.ErrorResponse{
 				Error:   "UNAUTHORIZED",
@@ -22,7 +52,8 @@ func AdminAuth() gin.HandlerFunc {
 			return
 		}
 
-		if adminPassword != config.AppConfig.AdminPassword {
+		name, ok := authenticateAdmin(accounts, adminPassword, adminName)
+		if !ok {
 			c.JSON(http.StatusUnauthorized, errors.ErrorResponse{
 				Error:   "UNAUTHORIZED",
 				Message: "관리자 인증에 실패했습니다.",
@@ -31,6 +62,120 @@ func AdminAuth() gin.HandlerFunc {
 			return
 		}
 
+		// 상태 변경 이력, 감사 기록 등에 남길 관리자 이름
+		c.Set(adminNameKey, name)
+
 		c.Next()
 	}
 }
+
+// adminCredentials 요청의 관리자 비밀번호와 이름 (헤더가 없으면 WebSocket 서브프로토콜에서 찾음)
+func adminCredentials(req *http.Request) (password string, name string) {
+	if password := req.Header.Get("X-Admin-Password"); password != "" {
+		return password, req.Header.Get("X-Admin-Name")
+	}
+
+	for _, protocol := range strings.Split(req.Header.Get("Sec-WebSocket-Protocol"), ",") {
+		protocol = strings.TrimSpace(protocol)
+		if encoded, ok := strings.CutPrefix(protocol, AdminPasswordProtocolPrefix); ok {
+			password = decodeProtocolValue(encoded)
+		} else if encoded, ok := strings.CutPrefix(protocol, AdminNameProtocolPrefix); ok {
+			name = decodeProtocolValue(encoded)
+		}
+	}
+	return password, name
+}
+
+// decodeProtocolValue 서브프로토콜에 담긴 값 디코딩 (형식이 잘못되면 빈 문자열)
+func decodeProtocolValue(encoded string) string {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ""
+	}
+	return string(decoded)
+}
+
+// ParseAdminAccounts ADMIN_ACCOUNTS 값("이름:비밀번호"를 쉼표로 구분) 파싱 (빈 값이면 nil)
+func ParseAdminAccounts(raw string) ([]AdminAccount, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var accounts []AdminAccount
+	names := make(map[string]bool)
+	passwords := make(map[string]bool)
+	for _, entry := range strings.Split(raw, ",") {
+		name, password, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || password == "" {
+			return nil, fmt.Errorf("admin account %q must be in name:password form", entry)
+		}
+
+		if len([]rune(name)) > maxAdminNameLength {
+			return nil, fmt.Errorf("admin name %q is longer than %d characters", name, maxAdminNameLength)
+		}
+
+		// 비밀번호로 관리자를 구분하므로 이름과 비밀번호 모두 겹치면 안 됨
+		if names[name] {
+			return nil, fmt.Errorf("duplicate admin name %q", name)
+		}
+		if passwords[password] {
+			return nil, fmt.Errorf("admin %q shares a password with another admin", name)
+		}
+		names[name] = true
+		passwords[password] = true
+
+		accounts = append(accounts, AdminAccount{Name: name, Password: password})
+	}
+
+	return accounts, nil
+}
+
+// authenticateAdmin 비밀번호를 확인하고 기록에 남길 관리자 이름 반환
+func authenticateAdmin(accounts []AdminAccount, password string, headerName string) (string, bool) {
+	if accounts != nil {
+		name := ""
+		for _, account := range accounts {
+			// 어느 관리자와 일치하는지 응답 시간으로 드러나지 않도록 모든 계정과 비교
+			if subtle.ConstantTimeCompare([]byte(password), []byte(account.Password)) == 1 {
+				name = account.Name
+			}
+		}
+		return name, name != ""
+	}
+
+	if subtle.ConstantTimeCompare([]byte(password), []byte(config.AppConfig.AdminPassword)) != 1 {
+		return "", false
+	}
+	return adminNameFromHeader(headerName) + unverifiedAdminSuffix, true
+}
+
+// adminNameKey 컨텍스트에 관리자 이름을 저장하는 키
+const adminNameKey = "adminName"
+
+// defaultAdminName 공용 비밀번호로 인증하고 X-Admin-Name 헤더가 없을 때 사용하는 관리자 이름
+const defaultAdminName = "admin"
+
+// unverifiedAdminSuffix 공용 비밀번호로 인증해 본인 확인이 안 된 관리자 이름에 붙이는 표시
+const unverifiedAdminSuffix = " (unverified)"
+
+// AdminName AdminAuth를 통과한 요청의 관리자 이름
+// 공용 비밀번호로 인증한 경우 X-Admin-Name 헤더의 이름 뒤에 " (unverified)"가 붙는다.
+func AdminName(c *gin.Context) string {
+	if name := c.GetString(adminNameKey); name != "" {
+		return name
+	}
+	return defaultAdminName + unverifiedAdminSuffix
+}
+
+func adminNameFromHeader(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return defaultAdminName
+	}
+
+	if runes := []rune(name); len(runes) > maxAdminNameLength {
+		name = string(runes[:maxAdminNameLength])
+	}
+	return name
+}
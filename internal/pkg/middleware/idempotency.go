@@ -0,0 +1,192 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/config"
+	"github.com/myramen/be/internal/pkg/encryption"
+	"github.com/myramen/be/internal/pkg/utils/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IdempotencyKeyHeader 멱등성 키 요청 헤더
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLength 멱등성 키 최대 길이
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyRecord 멱등성 키로 저장된 요청과 응답
+// Key는 요청 헤더 값의 해시이고, ResponseBody는 헤더 값으로만 열 수 있게 암호화해서 저장한다.
+// 응답에 주문 조회 토큰 같은 값이 들어 있어도 DB만으로는 읽을 수 없다.
+type IdempotencyRecord struct {
+	Key          string
+	Scope        string
+	RequestHash  string
+	StatusCode   int
+	ResponseBody []byte
+	Completed    bool
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// IdempotencyStore 멱등성 키 저장소 인터페이스
+type IdempotencyStore interface {
+	// Reserve 키를 선점. 이미 유효한 기록이 있으면 선점하지 않고 기존 기록을 반환
+	// 만료된 기록은 지우고 새로 선점한다.
+	Reserve(ctx context.Context, record *IdempotencyRecord) (existing *IdempotencyRecord, err error)
+
+	// Complete 선점한 키에 최종 응답 저장
+	Complete(ctx context.Context, key, scope string, statusCode int, body []byte) error
+
+	// Release 선점한 키 삭제 (서버 오류로 재시도가 필요한 경우)
+	Release(ctx context.Context, key, scope string) error
+}
+
+// Idempotency Idempotency-Key 헤더 처리 미들웨어
+// 같은 키와 같은 본문으로 다시 요청하면 처음 응답을 그대로 재전송하고,
+// 같은 키에 다른 본문을 보내면 422, 처음 요청이 아직 처리 중이면 409를 반환한다.
+// 헤더가 없으면 그대로 통과한다. 5xx 응답이나 핸들러 패닉은 저장하지 않아 재시도할 수 있다.
+func Idempotency(store IdempotencyStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			c.JSON(http.StatusBadRequest, errors.ErrorResponse{
+				Error:   "INVALID_IDEMPOTENCY_KEY",
+				Message: "Idempotency-Key는 255자 이하여야 합니다.",
+			})
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, errors.ErrorResponse{
+				Error:   "INVALID_REQUEST",
+				Message: "요청 본문을 읽을 수 없습니다.",
+			})
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		storedKey := hashIdempotencyKey(key)
+		scope := c.Request.Method + " " + c.FullPath()
+		sum := sha256.Sum256(body)
+		now := time.Now()
+
+		existing, err := store.Reserve(c, &IdempotencyRecord{
+			Key:         storedKey,
+			Scope:       scope,
+			RequestHash: hex.EncodeToString(sum[:]),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(config.AppConfig.IdempotencyTTL),
+		})
+		if err != nil {
+			errors.HandleError(c, err)
+			c.Abort()
+			return
+		}
+
+		if existing != nil {
+			replayIdempotentResponse(c, existing, key, hex.EncodeToString(sum[:]))
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		// 5xx 응답이거나 핸들러가 패닉을 일으켜 응답을 저장하지 못하면 키를 해제해서 바로 재시도할 수 있게 함
+		stored := false
+		defer func() {
+			if stored {
+				return
+			}
+
+			if err := store.Release(context.WithoutCancel(c.Request.Context()), storedKey, scope); err != nil {
+				c.Error(err)
+			}
+		}()
+
+		c.Next()
+
+		status := recorder.Status()
+		if status >= http.StatusInternalServerError {
+			return
+		}
+
+		sealed, err := encryption.SealWithSecret(key, recorder.body.Bytes())
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		stored = true
+		if err := store.Complete(c, storedKey, scope, status, sealed); err != nil {
+			c.Error(err)
+		}
+	}
+}
+
+// hashIdempotencyKey 저장용 멱등성 키 (헤더 값 원문은 응답 복호화에 쓰므로 저장하지 않음)
+func hashIdempotencyKey(key string) string {
+	sum := sha256.Sum256([]byte("myramen:idempotency-key:" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// replayIdempotentResponse 기존 기록에 따라 응답 재전송 또는 충돌 응답
+func replayIdempotentResponse(c *gin.Context, existing *IdempotencyRecord, key string, requestHash string) {
+	defer c.Abort()
+
+	if existing.RequestHash != requestHash {
+		c.JSON(http.StatusUnprocessableEntity, errors.ErrorResponse{
+			Error:   "IDEMPOTENCY_KEY_REUSED",
+			Message: "같은 Idempotency-Key로 다른 요청을 보낼 수 없습니다.",
+		})
+		return
+	}
+
+	if !existing.Completed {
+		c.JSON(http.StatusConflict, errors.ErrorResponse{
+			Error:   "IDEMPOTENCY_REQUEST_IN_PROGRESS",
+			Message: "같은 Idempotency-Key의 요청을 처리하고 있습니다. 잠시 후 다시 시도해주세요.",
+		})
+		return
+	}
+
+	body, err := encryption.OpenWithSecret(key, existing.ResponseBody)
+	if err != nil {
+		errors.HandleError(c, errors.Internal("INTERNAL_ERROR", "저장된 응답을 읽는데 실패했습니다."))
+		return
+	}
+
+	c.Header("Idempotent-Replayed", "true")
+	c.Data(existing.StatusCode, "application/json; charset=utf-8", body)
+}
+
+// responseRecorder 응답 본문을 저장하기 위해 복사해두는 ResponseWriter
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
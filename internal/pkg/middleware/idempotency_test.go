@@ -0,0 +1,272 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/myramen/be/internal/pkg/config"
+	"github.com/myramen/be/internal/pkg/encryption"
+
+	"github.com/gin-gonic/gin"
+)
+
+// memIdempotencyStore 멱등성 기록을 메모리에 보관하는 저장소
+type memIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]*IdempotencyRecord
+}
+
+func newMemIdempotencyStore() *memIdempotencyStore {
+	return &memIdempotencyStore{records: make(map[string]*IdempotencyRecord)}
+}
+
+func (s *memIdempotencyStore) Reserve(ctx context.Context, record *IdempotencyRecord) (*IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := record.Scope + " " + record.Key
+	if existing, ok := s.records[id]; ok && existing.ExpiresAt.After(record.CreatedAt) {
+		copied := *existing
+		return &copied, nil
+	}
+
+	reserved := *record
+	s.records[id] = &reserved
+	return nil, nil
+}
+
+func (s *memIdempotencyStore) Complete(ctx context.Context, key, scope string, statusCode int, body []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record := s.records[scope+" "+key]
+	record.StatusCode = statusCode
+	record.ResponseBody = body
+	record.Completed = true
+	return nil
+}
+
+func (s *memIdempotencyStore) Release(ctx context.Context, key, scope string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, scope+" "+key)
+	return nil
+}
+
+func (s *memIdempotencyStore) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.records)
+}
+
+// only 저장된 기록 하나 반환
+func (s *memIdempotencyStore) only(t *testing.T) IdempotencyRecord {
+	t.Helper()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.records) != 1 {
+		t.Fatalf("store has %d records, want 1", len(s.records))
+	}
+	for _, record := range s.records {
+		return *record
+	}
+	return IdempotencyRecord{}
+}
+
+// idempotencyTestRouter POST /orders에 멱등성 미들웨어와 handler를 등록한 라우터
+func idempotencyTestRouter(t *testing.T, store IdempotencyStore, handler gin.HandlerFunc) *gin.Engine {
+	t.Helper()
+
+	previous := config.AppConfig
+	config.AppConfig.IdempotencyTTL = time.Hour
+	t.Cleanup(func() { config.AppConfig = previous })
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(gin.Recovery())
+	router.POST("/orders", Idempotency(store), handler)
+	return router
+}
+
+func postWithKey(router *gin.Engine, key string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotencyReplaysFirstResponse(t *testing.T) {
+	store := newMemIdempotencyStore()
+	calls := 0
+	router := idempotencyTestRouter(t, store, func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"orderId": "o1", "lookupToken": "secret-token", "call": calls})
+	})
+
+	first := postWithKey(router, "key-1", `{"quantity":1}`)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("first status = %d, want 201", first.Code)
+	}
+
+	replayed := postWithKey(router, "key-1", `{"quantity":1}`)
+	if replayed.Code != http.StatusCreated || replayed.Body.String() != first.Body.String() {
+		t.Fatalf("replay = %d %s, want 201 %s", replayed.Code, replayed.Body, first.Body)
+	}
+	if replayed.Header().Get("Idempotent-Replayed") != "true" {
+		t.Error("replayed response has no Idempotent-Replayed header")
+	}
+	if calls != 1 {
+		t.Errorf("handler called %d times, want 1", calls)
+	}
+
+	// 키 원문과 응답 평문은 저장하지 않음
+	record := store.only(t)
+	if record.Key == "key-1" || record.Key != hashIdempotencyKey("key-1") {
+		t.Errorf("stored key = %q, want the hash of the header value", record.Key)
+	}
+	if bytes.Contains(record.ResponseBody, []byte("secret-token")) {
+		t.Error("stored response body contains the plaintext token")
+	}
+
+	opened, err := encryption.OpenWithSecret("key-1", record.ResponseBody)
+	if err != nil || string(opened) != first.Body.String() {
+		t.Errorf("stored body opened with the key = %s, %v, want the first response", opened, err)
+	}
+	if _, err := encryption.OpenWithSecret("key-2", record.ResponseBody); err == nil {
+		t.Error("stored body opened with another key")
+	}
+
+	// 다른 키는 새 요청으로 처리
+	if w := postWithKey(router, "key-2", `{"quantity":1}`); w.Code != http.StatusCreated || calls != 2 {
+		t.Errorf("request with another key = %d after %d calls, want a new 201", w.Code, calls)
+	}
+}
+
+func TestIdempotencyRejectsReusedKeyWithDifferentBody(t *testing.T) {
+	store := newMemIdempotencyStore()
+	calls := 0
+	router := idempotencyTestRouter(t, store, func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"orderId": "o1"})
+	})
+
+	postWithKey(router, "key-1", `{"quantity":1}`)
+
+	w := postWithKey(router, "key-1", `{"quantity":2}`)
+	if w.Code != http.StatusUnprocessableEntity || !strings.Contains(w.Body.String(), "IDEMPOTENCY_KEY_REUSED") {
+		t.Fatalf("reused key = %d %s, want 422 IDEMPOTENCY_KEY_REUSED", w.Code, w.Body)
+	}
+	if calls != 1 {
+		t.Errorf("handler called %d times, want 1", calls)
+	}
+}
+
+func TestIdempotencyRejectsRequestInProgress(t *testing.T) {
+	store := newMemIdempotencyStore()
+	calls := 0
+	router := idempotencyTestRouter(t, store, func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"orderId": "o1"})
+	})
+
+	// 같은 키의 첫 요청이 아직 처리 중
+	body := `{"quantity":1}`
+	sum := sha256.Sum256([]byte(body))
+	now := time.Now()
+	store.Reserve(context.Background(), &IdempotencyRecord{
+		Key:         hashIdempotencyKey("key-1"),
+		Scope:       "POST /orders",
+		RequestHash: hex.EncodeToString(sum[:]),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+	})
+
+	w := postWithKey(router, "key-1", body)
+	if w.Code != http.StatusConflict || !strings.Contains(w.Body.String(), "IDEMPOTENCY_REQUEST_IN_PROGRESS") {
+		t.Fatalf("in-progress key = %d %s, want 409 IDEMPOTENCY_REQUEST_IN_PROGRESS", w.Code, w.Body)
+	}
+	if calls != 0 {
+		t.Errorf("handler called %d times, want 0", calls)
+	}
+}
+
+func TestIdempotencyReleasesKeyOnServerError(t *testing.T) {
+	store := newMemIdempotencyStore()
+	calls := 0
+	router := idempotencyTestRouter(t, store, func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "INTERNAL_ERROR"})
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"orderId": "o1"})
+	})
+
+	if w := postWithKey(router, "key-1", `{"quantity":1}`); w.Code != http.StatusInternalServerError {
+		t.Fatalf("first status = %d, want 500", w.Code)
+	}
+	if n := store.count(); n != 0 {
+		t.Fatalf("store has %d records after a 500, want 0", n)
+	}
+
+	if w := postWithKey(router, "key-1", `{"quantity":1}`); w.Code != http.StatusCreated || calls != 2 {
+		t.Errorf("retry = %d after %d calls, want 201 from the handler", w.Code, calls)
+	}
+}
+
+func TestIdempotencyReleasesKeyOnPanic(t *testing.T) {
+	store := newMemIdempotencyStore()
+	calls := 0
+	router := idempotencyTestRouter(t, store, func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			panic("handler failed")
+		}
+		c.JSON(http.StatusCreated, gin.H{"orderId": "o1"})
+	})
+
+	if w := postWithKey(router, "key-1", `{"quantity":1}`); w.Code != http.StatusInternalServerError {
+		t.Fatalf("first status = %d, want 500 from recovery", w.Code)
+	}
+	if n := store.count(); n != 0 {
+		t.Fatalf("store has %d records after a panic, want 0", n)
+	}
+
+	if w := postWithKey(router, "key-1", `{"quantity":1}`); w.Code != http.StatusCreated || calls != 2 {
+		t.Errorf("retry = %d after %d calls, want 201 from the handler", w.Code, calls)
+	}
+}
+
+func TestIdempotencyPassesThroughWithoutKey(t *testing.T) {
+	store := newMemIdempotencyStore()
+	router := idempotencyTestRouter(t, store, func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"orderId": "o1"})
+	})
+
+	if w := postWithKey(router, "", `{"quantity":1}`); w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", w.Code)
+	}
+	if n := store.count(); n != 0 {
+		t.Errorf("store has %d records for a request without a key, want 0", n)
+	}
+
+	if w := postWithKey(router, strings.Repeat("k", maxIdempotencyKeyLength+1), `{}`); w.Code != http.StatusBadRequest {
+		t.Errorf("status for a long key = %d, want 400", w.Code)
+	}
+}
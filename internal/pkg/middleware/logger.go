@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// redactedQueryParams 접근 로그에 값을 남기지 않는 쿼리 파라미터 (EventSource로 보내는 주문 조회 토큰)
+var redactedQueryParams = []string{"token"}
+
+// Logger 접근 로그 미들웨어
+// gin.Logger와 같은 형식으로 기록하되, 쿼리 파라미터로 받은 비밀 값은 가려서 남긴다.
+func Logger() gin.HandlerFunc {
+	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
+		var statusColor, methodColor, resetColor string
+		if param.IsOutputColor() {
+			statusColor = param.StatusCodeColor()
+			methodColor = param.MethodColor()
+			resetColor = param.ResetColor()
+		}
+
+		if param.Latency > time.Minute {
+			param.Latency = param.Latency.Truncate(time.Second)
+		}
+
+		return fmt.Sprintf("[GIN] %v |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
+			param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+			statusColor, param.StatusCode, resetColor,
+			param.Latency,
+			param.ClientIP,
+			methodColor, param.Method, resetColor,
+			redactPath(param.Path),
+			param.ErrorMessage,
+		)
+	})
+}
+
+// redactPath 경로의 쿼리 문자열에서 비밀 파라미터 값을 가림
+func redactPath(path string) string {
+	base, rawQuery, ok := strings.Cut(path, "?")
+	if !ok {
+		return path
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		// 파싱할 수 없는 쿼리는 비밀 값이 들어 있는지 알 수 없으므로 통째로 가림
+		return base + "?REDACTED"
+	}
+
+	redacted := false
+	for _, name := range redactedQueryParams {
+		if _, exists := query[name]; exists {
+			query.Set(name, "REDACTED")
+			redacted = true
+		}
+	}
+
+	if !redacted {
+		return path
+	}
+	return base + "?" + query.Encode()
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import "testing"
+
+func TestRedactPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/api/v1/orders/o1", want: "/api/v1/orders/o1"},
+		{path: "/api/v1/orders/o1/events?token=secret", want: "/api/v1/orders/o1/events?token=REDACTED"},
+		{path: "/api/v1/orders/o1/events?lastEventId=42&token=secret", want: "/api/v1/orders/o1/events?lastEventId=42&token=REDACTED"},
+		{path: "/api/v1/admin/kitchen/ws?lastEventId=42", want: "/api/v1/admin/kitchen/ws?lastEventId=42"},
+		{path: "/api/v1/orders/o1/events?token=%zz", want: "/api/v1/orders/o1/events?REDACTED"},
+	}
+
+	for _, tt := range tests {
+		if got := redactPath(tt.path); got != tt.want {
+			t.Errorf("redactPath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
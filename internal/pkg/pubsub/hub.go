@@ -0,0 +1,160 @@
+package pubsub
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// subscriptionBufferSize 구독자별 대기 이벤트 수 (가득 차면 느린 구독자로 보고 연결을 끊음)
+const subscriptionBufferSize = 64
+
+// Event 허브로 전달되는 이벤트
+type Event struct {
+	// ID 재연결 시 이어받기에 사용하는 ID ("<허브 시작 시각>-<순번>")
+	ID    string
+	Topic string
+	Type  string
+	Data  interface{}
+	Time  time.Time
+
+	seq uint64
+}
+
+// Subscription 허브 구독
+// C가 닫히면 구독이 끝난 것이다. (허브 종료, 구독 해지, 또는 처리 지연으로 인한 연결 해제)
+type Subscription struct {
+	C <-chan Event
+
+	ch    chan Event
+	match func(Event) bool
+	hub   *Hub
+	once  sync.Once
+}
+
+// Close 구독 해지
+func (s *Subscription) Close() {
+	s.hub.remove(s)
+}
+
+// Hub 프로세스 내부 발행/구독 허브
+// 최근 이벤트를 보관해 재연결한 구독자가 놓친 이벤트를 다시 받을 수 있게 한다.
+type Hub struct {
+	mu      sync.Mutex
+	epoch   string
+	seq     uint64
+	history []Event
+	limit   int
+	subs    map[*Subscription]struct{}
+	closed  bool
+}
+
+// NewHub 최근 historySize개 이벤트를 보관하는 허브 생성
+func NewHub(historySize int) *Hub {
+	return &Hub{
+		epoch: strconv.FormatInt(time.Now().UnixMilli(), 36),
+		limit: historySize,
+		subs:  make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish 이벤트 발행
+func (h *Hub) Publish(topic, eventType string, data interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+
+	h.seq++
+	event := Event{
+		ID:    fmt.Sprintf("%s-%d", h.epoch, h.seq),
+		Topic: topic,
+		Type:  eventType,
+		Data:  data,
+		Time:  time.Now(),
+		seq:   h.seq,
+	}
+
+	h.history = append(h.history, event)
+	if len(h.history) > h.limit {
+		h.history = h.history[len(h.history)-h.limit:]
+	}
+
+	for sub := range h.subs {
+		if !sub.match(event) {
+			continue
+		}
+
+		select {
+		case sub.ch <- event:
+		default:
+			// 처리하지 못하는 구독자는 끊어서 재연결 후 이어받도록 함
+			h.closeLocked(sub)
+		}
+	}
+}
+
+// Subscribe match에 해당하는 이벤트 구독
+// lastEventID가 주어지면 그 이후에 발행된 보관 이벤트를 replay로 함께 반환한다.
+// 서버가 재시작되어 ID의 허브 시작 시각이 다르면 보관 중인 이벤트를 모두 반환한다.
+func (h *Hub) Subscribe(match func(Event) bool, lastEventID string) (*Subscription, []Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan Event, subscriptionBufferSize)
+	sub := &Subscription{C: ch, ch: ch, match: match, hub: h}
+
+	if h.closed {
+		close(ch)
+		return sub, nil
+	}
+
+	h.subs[sub] = struct{}{}
+
+	if lastEventID == "" {
+		return sub, nil
+	}
+
+	var after uint64
+	if epoch, seq, ok := strings.Cut(lastEventID, "-"); ok && epoch == h.epoch {
+		after, _ = strconv.ParseUint(seq, 10, 64)
+	}
+
+	var replay []Event
+	for _, event := range h.history {
+		if event.seq > after && match(event) {
+			replay = append(replay, event)
+		}
+	}
+
+	return sub, replay
+}
+
+// Close 허브 종료 (모든 구독을 닫고 이후 발행은 무시)
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for sub := range h.subs {
+		h.closeLocked(sub)
+	}
+}
+
+func (h *Hub) remove(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closeLocked(sub)
+}
+
+func (h *Hub) closeLocked(sub *Subscription) {
+	delete(h.subs, sub)
+	sub.once.Do(func() {
+		close(sub.ch)
+	})
+}
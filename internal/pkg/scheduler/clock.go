@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock 현재 시각과 대기를 제공하는 인터페이스 (테스트에서는 FakeClock으로 시간을 직접 진행)
+type Clock interface {
+	// Now 현재 시각
+	Now() time.Time
+
+	// After d가 지나면 그 시각을 보내는 채널
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock 시스템 시각을 사용하는 Clock
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// FakeClock Advance를 호출할 때만 시간이 흐르는 Clock
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewFakeClock now에 멈춰 있는 Clock 생성
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+
+	c.waiters = append(c.waiters, fakeWaiter{at: c.now.Add(d), ch: ch})
+	return ch
+}
+
+// Advance 시간을 d만큼 진행하고 그 사이에 끝나는 대기를 깨움
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	remaining := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.at.After(c.now) {
+			remaining = append(remaining, w)
+			continue
+		}
+		w.ch <- c.now
+	}
+	c.waiters = remaining
+}
+
+// Waiters 아직 끝나지 않은 대기 수 (Run이 다음 실행을 기다리는지 확인할 때 사용)
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
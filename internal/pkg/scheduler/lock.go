@@ -0,0 +1,67 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+)
+
+// Locker 여러 인스턴스 중 하나만 작업을 실행하도록 하는 잠금 (MySQL에서는 GET_LOCK)
+type Locker interface {
+	// TryLock 기다리지 않고 name 잠금을 시도 (다른 곳에서 가지고 있으면 nil)
+	TryLock(ctx context.Context, name string) (Lock, error)
+}
+
+// Lock 획득한 잠금
+type Lock interface {
+	// Held 잠금을 아직 가지고 있는지 여부 (DB 연결이 끊기면 false)
+	Held(ctx context.Context) bool
+
+	// Release 잠금 해제
+	Release(ctx context.Context) error
+}
+
+// LocalLocker 프로세스 안에서만 유효한 Locker (단일 인스턴스 실행과 테스트용)
+type LocalLocker struct {
+	mu   sync.Mutex
+	held map[string]*localLock
+}
+
+// NewLocalLocker 프로세스 내부 잠금 생성
+func NewLocalLocker() *LocalLocker {
+	return &LocalLocker{held: make(map[string]*localLock)}
+}
+
+func (l *LocalLocker) TryLock(ctx context.Context, name string) (Lock, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.held[name] != nil {
+		return nil, nil
+	}
+
+	lock := &localLock{locker: l, name: name}
+	l.held[name] = lock
+	return lock, nil
+}
+
+type localLock struct {
+	locker *LocalLocker
+	name   string
+}
+
+func (l *localLock) Held(ctx context.Context) bool {
+	l.locker.mu.Lock()
+	defer l.locker.mu.Unlock()
+	return l.locker.held[l.name] == l
+}
+
+func (l *localLock) Release(ctx context.Context) error {
+	l.locker.mu.Lock()
+	defer l.locker.mu.Unlock()
+
+	// 이미 잃은 잠금을 해제해도 새 소유자의 잠금은 풀지 않음
+	if l.locker.held[l.name] == l {
+		delete(l.locker.held, l.name)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// releaseTimeout 종료 시 리더 잠금을 해제하는 최대 시간
+const releaseTimeout = 5 * time.Second
+
+// Job 주기적으로 실행하는 작업
+// 리더가 바뀌면 새 리더가 바로 다시 실행할 수 있으므로, 같은 시각에 두 번 실행해도 결과가 같아야 한다.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context, now time.Time) error
+}
+
+// Scheduler 등록한 작업을 주기마다 실행하는 백그라운드 작업
+// 여러 인스턴스가 떠 있어도 리더 잠금을 가진 인스턴스 하나만 작업을 실행한다.
+type Scheduler struct {
+	clock    Clock
+	locker   Locker
+	lockName string
+	interval time.Duration
+	jobs     []*scheduledJob
+	lock     Lock
+}
+
+type scheduledJob struct {
+	Job
+	next time.Time
+}
+
+// New 스케줄러 생성 (interval마다 리더 잠금을 확인하고 실행할 때가 된 작업을 실행)
+func New(clock Clock, locker Locker, lockName string, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		clock:    clock,
+		locker:   locker,
+		lockName: lockName,
+		interval: interval,
+	}
+}
+
+// Register 작업 등록 (Run 전에 호출, 리더가 되면 바로 한 번 실행)
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, &scheduledJob{Job: job})
+}
+
+// Run ctx가 취소될 때까지 작업 실행 (실행 중인 작업은 끝까지 실행하고 리더 잠금을 해제한 뒤 반환)
+func (s *Scheduler) Run(ctx context.Context) {
+	for {
+		s.Tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			s.resign(context.WithoutCancel(ctx))
+			return
+		case <-s.clock.After(s.interval):
+		}
+	}
+}
+
+// Tick 리더 잠금을 확인하고 실행할 때가 된 작업을 한 번씩 실행 (리더가 아니면 아무것도 하지 않음)
+func (s *Scheduler) Tick(ctx context.Context) {
+	if !s.lead(ctx) {
+		return
+	}
+
+	for _, job := range s.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		now := s.clock.Now()
+		if now.Before(job.next) {
+			continue
+		}
+
+		if err := runJob(ctx, job.Job, now); err != nil {
+			log.Printf("scheduler: job %s failed: %v", job.Name, err)
+		}
+		job.next = now.Add(job.Interval)
+	}
+}
+
+// lead 리더 잠금을 확인하고 없으면 획득 시도
+func (s *Scheduler) lead(ctx context.Context) bool {
+	if s.lock != nil {
+		if s.lock.Held(ctx) {
+			return true
+		}
+
+		log.Printf("scheduler: lost leadership")
+		s.resign(ctx)
+	}
+
+	lock, err := s.locker.TryLock(ctx, s.lockName)
+	if err != nil {
+		log.Printf("scheduler: failed to acquire leadership: %v", err)
+		return false
+	}
+
+	if lock == nil {
+		return false
+	}
+
+	log.Printf("scheduler: acquired leadership")
+	s.lock = lock
+	return true
+}
+
+// resign 리더 잠금 해제
+func (s *Scheduler) resign(ctx context.Context) {
+	if s.lock == nil {
+		return
+	}
+
+	releaseCtx, cancel := context.WithTimeout(ctx, releaseTimeout)
+	defer cancel()
+
+	if err := s.lock.Release(releaseCtx); err != nil {
+		log.Printf("scheduler: failed to release leadership: %v", err)
+	}
+	s.lock = nil
+}
+
+// runJob 작업 실행 (패닉이 나도 스케줄러는 멈추지 않음)
+func runJob(ctx context.Context, job Job, now time.Time) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+
+	return job.Run(ctx, now)
+}
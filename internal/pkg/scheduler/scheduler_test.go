@@ -0,0 +1,176 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testLockName = "test-scheduler"
+
+// jobRecorder 작업이 실행된 시각을 기록
+type jobRecorder struct {
+	mu   sync.Mutex
+	runs []time.Time
+}
+
+func (r *jobRecorder) job(interval time.Duration) Job {
+	return Job{
+		Name:     "test-job",
+		Interval: interval,
+		Run: func(ctx context.Context, now time.Time) error {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.runs = append(r.runs, now)
+			return nil
+		},
+	}
+}
+
+func (r *jobRecorder) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.runs)
+}
+
+// waitForTick Run이 Tick을 마치고 다음 주기를 기다릴 때까지 대기
+func waitForTick(t *testing.T, clock *FakeClock) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for clock.Waiters() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("scheduler did not wait for the next tick")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSchedulerRunsJobOncePerInterval(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	recorder := &jobRecorder{}
+
+	s := New(clock, NewLocalLocker(), testLockName, 10*time.Second)
+	s.Register(recorder.job(time.Minute))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+
+	// 리더가 되면 바로 한 번 실행
+	waitForTick(t, clock)
+	if got := recorder.count(); got != 1 {
+		t.Fatalf("runs after start = %d, want 1", got)
+	}
+
+	// 주기(1분)가 지나기 전의 tick에서는 실행하지 않음
+	for i := 0; i < 5; i++ {
+		clock.Advance(10 * time.Second)
+		waitForTick(t, clock)
+	}
+	if got := recorder.count(); got != 1 {
+		t.Fatalf("runs after 50s = %d, want 1", got)
+	}
+
+	clock.Advance(10 * time.Second)
+	waitForTick(t, clock)
+	if got := recorder.count(); got != 2 {
+		t.Fatalf("runs after 1m = %d, want 2", got)
+	}
+
+	cancel()
+	<-done
+
+	want := []time.Time{start, start.Add(time.Minute)}
+	for i, run := range recorder.runs {
+		if !run.Equal(want[i]) {
+			t.Errorf("run %d at %s, want %s", i, run, want[i])
+		}
+	}
+}
+
+func TestSchedulerSkipsJobsWithoutLeadership(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	locker := NewLocalLocker()
+	recorder := &jobRecorder{}
+
+	// 다른 인스턴스가 리더 잠금을 가지고 있음
+	other, err := locker.TryLock(ctx, testLockName)
+	if err != nil || other == nil {
+		t.Fatalf("TryLock() = %v, %v, want a lock", other, err)
+	}
+
+	s := New(clock, locker, testLockName, 10*time.Second)
+	s.Register(recorder.job(time.Minute))
+
+	for i := 0; i < 3; i++ {
+		s.Tick(ctx)
+		clock.Advance(time.Minute)
+	}
+	if got := recorder.count(); got != 0 {
+		t.Fatalf("runs without leadership = %d, want 0", got)
+	}
+
+	// 리더가 잠금을 놓으면 다음 tick에서 이어받아 실행
+	if err := other.Release(ctx); err != nil {
+		t.Fatalf("Release() = %v", err)
+	}
+	s.Tick(ctx)
+	if got := recorder.count(); got != 1 {
+		t.Fatalf("runs after taking over leadership = %d, want 1", got)
+	}
+}
+
+func TestSchedulerStopsWhenLockIsLost(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	locker := NewLocalLocker()
+	first, second := &jobRecorder{}, &jobRecorder{}
+
+	a := New(clock, locker, testLockName, 10*time.Second)
+	a.Register(first.job(time.Minute))
+	b := New(clock, locker, testLockName, 10*time.Second)
+	b.Register(second.job(time.Minute))
+
+	a.Tick(ctx)
+	b.Tick(ctx)
+	if first.count() != 1 || second.count() != 0 {
+		t.Fatalf("runs = %d, %d, want only the leader to run", first.count(), second.count())
+	}
+
+	// DB 연결이 끊긴 것처럼 a의 잠금이 풀리고 b가 리더가 됨
+	if err := a.lock.Release(ctx); err != nil {
+		t.Fatalf("Release() = %v", err)
+	}
+	clock.Advance(time.Minute)
+	b.Tick(ctx)
+	a.Tick(ctx)
+
+	if got := first.count(); got != 1 {
+		t.Errorf("runs after losing the lock = %d, want 1", got)
+	}
+	if got := second.count(); got != 1 {
+		t.Errorf("runs of the new leader = %d, want 1", got)
+	}
+
+	// 잠금을 잃은 인스턴스가 물러나도 새 리더의 잠금은 유지됨
+	if a.lock != nil {
+		t.Error("scheduler kept a lost lock")
+	}
+	if b.lock == nil || !b.lock.Held(ctx) {
+		t.Fatal("new leader lost its lock when the old leader resigned")
+	}
+
+	clock.Advance(time.Minute)
+	a.Tick(ctx)
+	b.Tick(ctx)
+	if first.count() != 1 || second.count() != 2 {
+		t.Errorf("runs = %d, %d, want only the new leader to keep running", first.count(), second.count())
+	}
+}
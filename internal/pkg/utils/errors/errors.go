@@ -32,6 +32,7 @@ const (
 	StatusUnauthorized   = http.StatusUnauthorized
 	StatusForbidden      = http.StatusForbidden
 	StatusNotFound       = http.StatusNotFound
+	StatusConflict       = http.StatusConflict
 	StatusInternalServer = http.StatusInternalServerError
 )
 
@@ -65,6 +66,11 @@ func NotFound(code, message string) error {
 	return NewError(StatusNotFound, code, message, nil)
 }
 
+// Conflict 리소스 상태 충돌 에러
+func Conflict(code, message string) error {
+	return NewError(StatusConflict, code, message, nil)
+}
+
 // Internal 내부 서버 에러
 func Internal(code, message string) error {
 	return NewError(StatusInternalServer, code, message, nil)
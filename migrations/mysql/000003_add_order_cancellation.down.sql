@@ -0,0 +1,6 @@
+ALTER TABLE orders
+    DROP COLUMN refunded_at,
+    DROP COLUMN refund_note,
+    DROP COLUMN refund_amount,
+    DROP COLUMN cancelled_at,
+    DROP COLUMN cancel_reason;
@@ -0,0 +1,6 @@
+ALTER TABLE orders
+    ADD COLUMN cancel_reason VARCHAR(255) NULL AFTER new_coupon,
+    ADD COLUMN cancelled_at TIMESTAMP NULL AFTER cancel_reason,
+    ADD COLUMN refund_amount INT UNSIGNED NULL AFTER cancelled_at,
+    ADD COLUMN refund_note VARCHAR(255) NULL AFTER refund_amount,
+    ADD COLUMN refunded_at TIMESTAMP NULL AFTER refund_note;
@@ -0,0 +1,4 @@
+ALTER TABLE coupons
+    DROP INDEX idx_used_by_order_id,
+    DROP COLUMN used_at,
+    DROP COLUMN used_by_order_id;
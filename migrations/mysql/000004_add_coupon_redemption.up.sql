@@ -0,0 +1,4 @@
+ALTER TABLE coupons
+    ADD COLUMN used_by_order_id VARCHAR(50) NULL AFTER is_used,
+    ADD COLUMN used_at TIMESTAMP NULL AFTER used_by_order_id,
+    ADD INDEX idx_used_by_order_id (used_by_order_id);
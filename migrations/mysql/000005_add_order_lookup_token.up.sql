@@ -0,0 +1,2 @@
+ALTER TABLE orders
+    ADD COLUMN lookup_token_hash CHAR(64) NULL AFTER status;
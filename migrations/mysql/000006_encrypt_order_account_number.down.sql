@@ -0,0 +1,4 @@
+-- 암호화된 계좌번호가 남아 있으면 먼저 복호화해야 합니다.
+ALTER TABLE orders
+    DROP COLUMN account_key_id,
+    MODIFY COLUMN account_number VARCHAR(50) NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE orders
+    MODIFY COLUMN account_number VARCHAR(512) NOT NULL,
+    ADD COLUMN account_key_id VARCHAR(32) NULL AFTER account_number;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    idempotency_key VARCHAR(255) NOT NULL,
+    scope VARCHAR(100) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    status_code SMALLINT UNSIGNED NULL,
+    response_body MEDIUMBLOB NULL,
+    completed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    UNIQUE KEY uk_idempotency_key_scope (idempotency_key, scope),
+    INDEX idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
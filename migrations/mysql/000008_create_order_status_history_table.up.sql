@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    order_id VARCHAR(50) NOT NULL,
+    previous_status VARCHAR(20) NULL,
+    new_status VARCHAR(20) NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    note VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_order_id (order_id, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 기존 주문은 생성 시점과 마지막 변경 시점만 알 수 있으므로 두 단계로 기록
+INSERT INTO order_status_history (order_id, previous_status, new_status, actor, created_at)
+SELECT order_id, NULL, 'PENDING', 'customer', created_at
+FROM orders;
+
+INSERT INTO order_status_history (order_id, previous_status, new_status, actor, note, created_at)
+SELECT order_id, 'PENDING', status, 'system', '이력 기록 이전의 상태 변경', updated_at
+FROM orders
+WHERE status <> 'PENDING';
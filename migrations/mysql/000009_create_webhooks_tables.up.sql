@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    event_types JSON NOT NULL,
+    description VARCHAR(255) NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 전달할 이벤트 (주문 트랜잭션 안에서 기록되는 outbox)
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    subscription_id BIGINT UNSIGNED NOT NULL,
+    event_id VARCHAR(50) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSON NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP(3) NOT NULL,
+    last_status_code SMALLINT UNSIGNED NULL,
+    last_error VARCHAR(255) NULL,
+    delivered_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_status_next_attempt (status, next_attempt_at),
+    INDEX idx_subscription_id (subscription_id, id),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 전달 시도 기록
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    delivery_id BIGINT UNSIGNED NOT NULL,
+    status_code SMALLINT UNSIGNED NULL,
+    error VARCHAR(255) NULL,
+    response_body VARCHAR(1024) NULL,
+    duration_ms INT NOT NULL,
+    attempted_at TIMESTAMP(3) NOT NULL,
+    INDEX idx_delivery_id (delivery_id, id),
+    FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
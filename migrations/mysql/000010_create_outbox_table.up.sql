@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    seq BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    event_id VARCHAR(50) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    aggregate_type VARCHAR(20) NOT NULL,
+    aggregate_id VARCHAR(50) NOT NULL,
+    payload JSON NOT NULL,
+    occurred_at TIMESTAMP(3) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error VARCHAR(255) NULL,
+    available_at TIMESTAMP(3) NOT NULL,
+    published_at TIMESTAMP(3) NULL,
+    UNIQUE KEY uk_event_id (event_id),
+    INDEX idx_pending (published_at, seq),
+    INDEX idx_aggregate_pending (aggregate_type, aggregate_id, published_at, seq)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
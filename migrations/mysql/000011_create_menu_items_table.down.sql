@@ -0,0 +1,7 @@
+ALTER TABLE orders
+    DROP COLUMN options_price,
+    DROP COLUMN unit_price,
+    DROP COLUMN item_name,
+    DROP COLUMN menu_item_id;
+
+DROP TABLE IF EXISTS menu_items;
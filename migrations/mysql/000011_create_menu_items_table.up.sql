@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS menu_items (
+    item_id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(255) NULL,
+    category VARCHAR(20) NOT NULL,
+    price INT UNSIGNED NOT NULL,
+    available BOOLEAN NOT NULL DEFAULT TRUE,
+    display_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_category_display_order (category, display_order)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 기존 고정 가격을 메뉴로 옮김
+INSERT INTO menu_items (item_id, name, category, price, display_order) VALUES
+    ('shin_ramyun', '신라면', 'RAMEN', 4000, 1),
+    ('hot_water_delivery', '뜨거운 물 배달 서비스', 'OPTION', 500, 100),
+    ('cooking_service', '조리 서비스', 'OPTION', 500, 101);
+
+-- 주문 시점의 메뉴와 가격 보관 (메뉴 가격이 바뀌어도 기존 주문은 그대로 유지)
+ALTER TABLE orders
+    ADD COLUMN menu_item_id VARCHAR(50) NULL AFTER quantity,
+    ADD COLUMN item_name VARCHAR(100) NULL AFTER menu_item_id,
+    ADD COLUMN unit_price INT UNSIGNED NULL AFTER item_name,
+    ADD COLUMN options_price INT UNSIGNED NULL AFTER options;
+
+UPDATE orders
+SET menu_item_id = 'shin_ramyun',
+    item_name = '신라면',
+    unit_price = 4000,
+    options_price = IF(JSON_EXTRACT(options, '$.hotWaterDelivery') = TRUE, 500, 0)
+        + IF(JSON_EXTRACT(options, '$.cookingService') = TRUE, 500, 0);
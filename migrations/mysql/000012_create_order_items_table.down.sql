@@ -0,0 +1,15 @@
+ALTER TABLE orders
+    ADD COLUMN menu_item_id VARCHAR(50) NULL AFTER quantity,
+    ADD COLUMN item_name VARCHAR(100) NULL AFTER menu_item_id,
+    ADD COLUMN unit_price INT UNSIGNED NULL AFTER item_name,
+    ADD COLUMN spicy_level TINYINT UNSIGNED NOT NULL DEFAULT 3 AFTER unit_price;
+
+-- 여러 항목 주문은 첫 번째 항목으로만 되돌릴 수 있음
+UPDATE orders o
+JOIN order_items i ON i.order_id = o.order_id AND i.line_no = 1
+SET o.menu_item_id = i.menu_item_id,
+    o.item_name = i.item_name,
+    o.unit_price = i.unit_price,
+    o.spicy_level = i.spicy_level;
+
+DROP TABLE IF EXISTS order_items;
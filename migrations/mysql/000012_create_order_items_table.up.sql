@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS order_items (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    order_id VARCHAR(50) NOT NULL,
+    line_no SMALLINT UNSIGNED NOT NULL,
+    menu_item_id VARCHAR(50) NOT NULL,
+    item_name VARCHAR(100) NOT NULL,
+    unit_price INT UNSIGNED NOT NULL,
+    quantity INT UNSIGNED NOT NULL,
+    spicy_level TINYINT UNSIGNED NOT NULL,
+    toppings JSON NOT NULL,
+    line_price INT UNSIGNED NOT NULL,
+    UNIQUE KEY uk_order_line (order_id, line_no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 기존 주문은 한 줄짜리 주문 항목으로 옮김
+INSERT INTO order_items (
+    order_id, line_no, menu_item_id, item_name, unit_price, quantity, spicy_level, toppings, line_price
+)
+SELECT order_id, 1, menu_item_id, item_name, unit_price, quantity, spicy_level, JSON_ARRAY(), unit_price * quantity
+FROM orders;
+
+-- 메뉴와 매운맛은 주문 항목별로 보관 (orders.quantity는 전체 라면 수량)
+ALTER TABLE orders
+    DROP COLUMN spicy_level,
+    DROP COLUMN unit_price,
+    DROP COLUMN item_name,
+    DROP COLUMN menu_item_id;
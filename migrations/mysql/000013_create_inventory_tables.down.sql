@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS inventory_ledger;
+DROP TABLE IF EXISTS inventory_usages;
+DROP TABLE IF EXISTS inventory_items;
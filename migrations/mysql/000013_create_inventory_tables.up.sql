@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS inventory_items (
+    sku VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    quantity INT NOT NULL DEFAULT 0,
+    low_stock_threshold INT UNSIGNED NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 메뉴 한 개를 팔 때 소모하는 재고 (소모량이 없는 메뉴는 재고를 확인하지 않음)
+CREATE TABLE IF NOT EXISTS inventory_usages (
+    menu_item_id VARCHAR(50) NOT NULL,
+    sku VARCHAR(50) NOT NULL,
+    quantity INT UNSIGNED NOT NULL,
+    PRIMARY KEY (menu_item_id, sku),
+    INDEX idx_sku (sku)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS inventory_ledger (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    sku VARCHAR(50) NOT NULL,
+    delta INT NOT NULL,
+    balance INT NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    order_id VARCHAR(50) NULL,
+    actor VARCHAR(100) NOT NULL,
+    note VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_sku (sku, id),
+    INDEX idx_order_id (order_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
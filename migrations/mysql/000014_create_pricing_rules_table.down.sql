@@ -0,0 +1,4 @@
+ALTER TABLE orders
+    DROP COLUMN price_breakdown;
+
+DROP TABLE IF EXISTS pricing_rules;
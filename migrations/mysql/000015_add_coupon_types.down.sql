@@ -0,0 +1,6 @@
+ALTER TABLE coupons
+    DROP COLUMN conditions,
+    DROP COLUMN free_option,
+    DROP COLUMN max_discount,
+    DROP COLUMN discount_percent,
+    DROP COLUMN coupon_type;
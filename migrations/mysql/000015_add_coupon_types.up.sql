@@ -0,0 +1,6 @@
+ALTER TABLE coupons
+    ADD COLUMN coupon_type VARCHAR(20) NOT NULL DEFAULT 'FIXED' AFTER coupon_id,
+    ADD COLUMN discount_percent TINYINT UNSIGNED NOT NULL DEFAULT 0 AFTER discount,
+    ADD COLUMN max_discount INT UNSIGNED NOT NULL DEFAULT 0 AFTER discount_percent,
+    ADD COLUMN free_option VARCHAR(50) NULL AFTER max_discount,
+    ADD COLUMN conditions JSON NULL AFTER free_option;
@@ -0,0 +1,12 @@
+ALTER TABLE coupons
+    DROP INDEX idx_customer_id,
+    DROP COLUMN customer_id;
+
+ALTER TABLE orders
+    DROP INDEX idx_claim_key,
+    DROP INDEX idx_customer_id,
+    DROP COLUMN claim_key,
+    DROP COLUMN customer_id;
+
+DROP TABLE IF EXISTS customer_sessions;
+DROP TABLE IF EXISTS customers;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS customers (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    email VARCHAR(255) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    password_hash VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 로그인 세션 (토큰 원문은 저장하지 않고 SHA-256 해시만 저장)
+CREATE TABLE IF NOT EXISTS customer_sessions (
+    token_hash CHAR(64) PRIMARY KEY,
+    customer_id BIGINT UNSIGNED NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_customer_id (customer_id),
+    INDEX idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- claim_key: 이름과 계좌번호의 HMAC (비로그인 주문을 계정에 연결할 때 사용)
+ALTER TABLE orders
+    ADD COLUMN customer_id BIGINT UNSIGNED NULL AFTER order_id,
+    ADD COLUMN claim_key CHAR(64) NULL AFTER account_key_id,
+    ADD INDEX idx_customer_id (customer_id, created_at),
+    ADD INDEX idx_claim_key (claim_key);
+
+ALTER TABLE coupons
+    ADD COLUMN customer_id BIGINT UNSIGNED NULL AFTER coupon_id,
+    ADD INDEX idx_customer_id (customer_id);
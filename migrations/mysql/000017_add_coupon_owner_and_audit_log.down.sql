@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS coupon_audit_log;
+
+ALTER TABLE coupons
+    DROP COLUMN owner_key;
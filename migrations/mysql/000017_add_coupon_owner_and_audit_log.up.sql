@@ -0,0 +1,13 @@
+-- owner_key: 비로그인 주문으로 받은 쿠폰의 소유자 (주문의 claim_key, 이름 + 계좌번호 HMAC)
+ALTER TABLE coupons
+    ADD COLUMN owner_key CHAR(64) NULL AFTER customer_id;
+
+CREATE TABLE IF NOT EXISTS coupon_audit_log (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    coupon_id VARCHAR(50) NOT NULL,
+    action VARCHAR(30) NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    details JSON NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_coupon_id (coupon_id, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
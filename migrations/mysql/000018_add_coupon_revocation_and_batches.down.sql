@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS coupon_batches;
+
+ALTER TABLE coupons
+    DROP INDEX idx_batch_id,
+    DROP COLUMN revoked_reason,
+    DROP COLUMN revoked_at,
+    DROP COLUMN batch_id;
@@ -0,0 +1,15 @@
+-- revoked_at: 관리자가 사용을 중지한 일시 (사용 여부와 별개 상태)
+-- batch_id: 관리자 대량 발급으로 만든 쿠폰의 발급 묶음
+ALTER TABLE coupons
+    ADD COLUMN batch_id BIGINT UNSIGNED NULL AFTER owner_key,
+    ADD COLUMN revoked_at TIMESTAMP NULL AFTER used_at,
+    ADD COLUMN revoked_reason VARCHAR(200) NULL AFTER revoked_at,
+    ADD INDEX idx_batch_id (batch_id);
+
+CREATE TABLE IF NOT EXISTS coupon_batches (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    coupon_count INT UNSIGNED NOT NULL,
+    created_by VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,6 @@
+ALTER TABLE coupons
+    DROP INDEX idx_campaign_id,
+    DROP COLUMN campaign_id;
+
+DROP TABLE IF EXISTS campaign_redemptions;
+DROP TABLE IF EXISTS campaigns;
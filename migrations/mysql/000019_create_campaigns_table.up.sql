@@ -0,0 +1,48 @@
+-- 쿠폰 캠페인 (예산과 사용 한도, 구매 보상 쿠폰 규칙)
+-- budget, max_redemptions, max_per_customer는 0이면 제한 없음
+-- reward_*: 주문 수량이 reward_min_quantity 이상이면 reward_discount원 쿠폰을 발급하는 구매 보상 규칙 (없으면 NULL)
+CREATE TABLE IF NOT EXISTS campaigns (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    starts_at TIMESTAMP NULL,
+    ends_at TIMESTAMP NULL,
+    budget INT UNSIGNED NOT NULL DEFAULT 0,
+    max_redemptions INT UNSIGNED NOT NULL DEFAULT 0,
+    max_per_customer INT UNSIGNED NOT NULL DEFAULT 0,
+    reward_min_quantity INT UNSIGNED NULL,
+    reward_discount INT UNSIGNED NULL,
+    reward_valid_days INT UNSIGNED NULL,
+    spent INT UNSIGNED NOT NULL DEFAULT 0,
+    redemptions INT UNSIGNED NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 캠페인 쿠폰 사용 기록 (주문 취소 시 refunded_at을 기록하고 예산을 돌려줌)
+-- customer_key: 고객별 사용 한도 계산용 (로그인 주문은 customer:<고객 ID>, 비로그인 주문은 claim:<claim_key>)
+CREATE TABLE IF NOT EXISTS campaign_redemptions (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    campaign_id BIGINT UNSIGNED NOT NULL,
+    coupon_id VARCHAR(50) NOT NULL,
+    order_id VARCHAR(50) NOT NULL,
+    customer_key VARCHAR(100) NOT NULL DEFAULT '',
+    amount INT UNSIGNED NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    refunded_at TIMESTAMP NULL,
+    INDEX idx_campaign_customer (campaign_id, customer_key),
+    INDEX idx_order_id (order_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE coupons
+    ADD COLUMN campaign_id BIGINT UNSIGNED NULL AFTER batch_id,
+    ADD INDEX idx_campaign_id (campaign_id);
+
+-- 기존 구매 보상 쿠폰 규칙(3개 이상 주문 시 200원, 30일)을 캠페인으로 옮김
+INSERT INTO campaigns (name, reward_min_quantity, reward_discount, reward_valid_days) VALUES
+    ('구매 보상 쿠폰', 3, 200, 30);
+
+-- 이미 발급된 구매 보상 쿠폰을 캠페인에 연결 (사용 기록과 예산은 이후 주문부터 집계)
+UPDATE coupons c
+JOIN orders o ON c.coupon_id = JSON_UNQUOTE(JSON_EXTRACT(o.new_coupon, '$.couponId'))
+SET c.campaign_id = LAST_INSERT_ID();
@@ -0,0 +1,3 @@
+ALTER TABLE coupons
+    DROP COLUMN expiry_reminded_at,
+    DROP COLUMN expired_at;
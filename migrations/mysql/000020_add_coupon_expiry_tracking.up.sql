@@ -0,0 +1,10 @@
+-- expired_at: 만료 처리 작업이 쿠폰을 EXPIRED로 표시하고 coupon.expired 이벤트를 기록한 일시
+-- expiry_reminded_at: 만료 임박 알림(coupon.expiring_soon) 이벤트를 기록한 일시
+ALTER TABLE coupons
+    ADD COLUMN expired_at TIMESTAMP NULL AFTER revoked_reason,
+    ADD COLUMN expiry_reminded_at TIMESTAMP NULL AFTER expired_at;
+
+-- 이미 만료된 쿠폰은 만료 이벤트를 다시 보내지 않도록 만료일로 표시
+UPDATE coupons
+SET expired_at = expiry_date
+WHERE is_used = FALSE AND revoked_at IS NULL AND expiry_date <= NOW();
@@ -0,0 +1,2 @@
+-- 삭제한 기록은 되돌릴 수 없음 (최대 IDEMPOTENCY_TTL 동안만 보관하던 데이터)
+SELECT 1;
@@ -0,0 +1,3 @@
+-- 멱등성 키는 이제 헤더 값의 해시로, 응답 본문은 헤더 값으로만 열 수 있게 암호화해서 저장한다.
+-- 이전 형식으로 저장된 기록(평문 주문 조회 토큰 포함)은 새 형식으로 찾을 수 없으므로 모두 삭제한다.
+DELETE FROM idempotency_keys;
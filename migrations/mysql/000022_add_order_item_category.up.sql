@@ -0,0 +1,3 @@
+-- 주문 항목의 메뉴 분류 (라면, 음료). 기존 주문 항목은 모두 라면
+ALTER TABLE order_items
+    ADD COLUMN category VARCHAR(20) NOT NULL DEFAULT 'RAMEN' AFTER item_name;